@@ -5,6 +5,12 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog/log"
 )
@@ -15,16 +21,82 @@ import (
 type Specification struct {
 	// The Jenkins Base Url, like 'https://ci.jenkins.io'
 	BaseUrl string `json:"baseUrl" split_words:"true" required:"true"`
+	// How to authenticate against Jenkins: 'basic' (API user and token), 'bearer' (API token sent as bearer token) or 'oidc' (OAuth2 client credentials)
+	AuthMode string `json:"authMode" split_words:"true" required:"false" default:"basic"`
 	// The Jenkins API User
-	ApiUser string `json:"apiUser" split_words:"true" required:"true"`
+	ApiUser string `json:"apiUser" split_words:"true" required:"false"`
 	// The Jenkins API Token
-	ApiToken string `json:"apiToken" split_words:"true" required:"true"`
+	ApiToken string `json:"apiToken" split_words:"true" required:"false"`
+	// Path to a file containing the Jenkins API Token, re-read on change. Takes precedence over ApiToken.
+	ApiTokenFile string `json:"apiTokenFile" split_words:"true" required:"false"`
+	// The token endpoint of the OIDC provider, used for the client credentials flow
+	OidcTokenUrl string `json:"oidcTokenUrl" split_words:"true" required:"false"`
+	// The client id for the client credentials flow
+	OidcClientId string `json:"oidcClientId" split_words:"true" required:"false"`
+	// The client secret for the client credentials flow
+	OidcClientSecret string `json:"oidcClientSecret" split_words:"true" required:"false"`
+	// Path to a file containing the client secret, re-read on change. Takes precedence over OidcClientSecret.
+	OidcClientSecretFile string `json:"oidcClientSecretFile" split_words:"true" required:"false"`
+	// Scopes requested in the client credentials flow
+	OidcScopes []string `json:"oidcScopes" split_words:"true" required:"false"`
 	// If true, the extension will skip TLS verification when connecting to Jenkins
 	InsecureSkipVerify bool `json:"insecureSkipVerify" split_words:"true" required:"false" default:"false"`
+	// Path to a PEM file with CA certificates to trust for the Jenkins connection, in addition to the system roots. Reloaded on change.
+	CaFile string `json:"caFile" split_words:"true" required:"false"`
+	// PEM encoded CA certificates to trust for the Jenkins connection, in addition to the system roots
+	CaPem string `json:"caPem" split_words:"true" required:"false"`
+	// Path to the client certificate presented to Jenkins for mutual TLS. Reloaded on change.
+	ClientCertFile string `json:"clientCertFile" split_words:"true" required:"false"`
+	// Path to the key of the client certificate presented to Jenkins for mutual TLS
+	ClientKeyFile string `json:"clientKeyFile" split_words:"true" required:"false"`
+	// Timeout for a single request to Jenkins
+	HttpTimeoutSeconds int `json:"httpTimeoutSeconds" split_words:"true" required:"false" default:"30"`
+	// Proxy for requests to Jenkins, like 'http://proxy:3128'. If empty, the HTTP_PROXY and HTTPS_PROXY environment variables apply.
+	HttpProxy string `json:"httpProxy" split_words:"true" required:"false"`
+	// Comma-separated hosts and domains not to use the proxy for, in the format of NO_PROXY
+	HttpNoProxy string `json:"httpNoProxy" split_words:"true" required:"false"`
+	// Maximum number of idle connections kept open to Jenkins
+	HttpMaxIdleConnections int `json:"httpMaxIdleConnections" split_words:"true" required:"false" default:"10"`
+	// Interval of TCP keep-alive probes and how long idle connections are kept open. 0 disables keep-alive.
+	HttpKeepAliveSeconds int `json:"httpKeepAliveSeconds" split_words:"true" required:"false" default:"30"`
+	// OTLP/HTTP endpoint to export traces to, like 'http://otel-collector:4318'. Tracing is disabled if empty.
+	TracingOtlpEndpoint string `json:"tracingOtlpEndpoint" split_words:"true" required:"false"`
+	// Service name reported in traces
+	TracingServiceName string `json:"tracingServiceName" split_words:"true" required:"false" default:"steadybit-extension-jenkins"`
+	// Where to write the audit log of mutating requests to Jenkins: a file path or 'stdout'. Disabled if empty.
+	AuditLog string `json:"auditLog" split_words:"true" required:"false"`
+	// If true, all actions changing Jenkins are rejected
+	ReadOnly bool `json:"readOnly" split_words:"true" required:"false" default:"false"`
+	// If true, the action running Groovy scripts in the Script Console of Jenkins is available. Scripts run with full administrative rights.
+	ScriptActionEnabled bool `json:"scriptActionEnabled" split_words:"true" required:"false" default:"false"`
+	// If true, clouds and pod templates are discovered and their caps can be limited. Both use the Script Console, which requires the Overall/Administer permission.
+	CloudsEnabled bool `json:"cloudsEnabled" split_words:"true" required:"false" default:"false"`
+	// Regular expressions for the full names of jobs which may be run. If empty, all jobs not denied may be run.
+	GuardrailsAllowedJobs []string `json:"guardrailsAllowedJobs" split_words:"true" required:"false"`
+	// Regular expressions for the full names of jobs which must not be run
+	GuardrailsDeniedJobs []string `json:"guardrailsDeniedJobs" split_words:"true" required:"false"`
+	// Names of job parameters which must not be set by actions
+	GuardrailsProtectedParameters []string `json:"guardrailsProtectedParameters" split_words:"true" required:"false"`
+	// Directory with files holding secrets, which job parameters can reference like ${secret:FILE_NAME}
+	SecretsDirectory string `json:"secretsDirectory" split_words:"true" required:"false"`
 	// Timeout for a job to start, otherwise an error is returned
 	JobStartTimeoutSeconds int `json:"jobStartTimeoutSeconds" split_words:"true" required:"false" default:"60"`
 	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_JOB="jenkins.job.name.full".
 	DiscoveryAttributesExcludesJob []string `json:"discoveryAttributesExcludesJob" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_LOCKABLE_RESOURCE="jenkins.lockable-resource.description".
+	DiscoveryAttributesExcludesLockableResource []string `json:"discoveryAttributesExcludesLockableResource" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_CLOUD="jenkins.cloud.agent".
+	DiscoveryAttributesExcludesCloud []string `json:"discoveryAttributesExcludesCloud" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_POD_TEMPLATE="jenkins.pod-template.agent".
+	DiscoveryAttributesExcludesPodTemplate []string `json:"discoveryAttributesExcludesPodTemplate" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_NODE="jenkins.node.label".
+	DiscoveryAttributesExcludesNode []string `json:"discoveryAttributesExcludesNode" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_VIEW="jenkins.view.job".
+	DiscoveryAttributesExcludesView []string `json:"discoveryAttributesExcludesView" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_BUILD="jenkins.build.cause".
+	DiscoveryAttributesExcludesBuild []string `json:"discoveryAttributesExcludesBuild" split_words:"true" required:"false"`
+	// variable STEADYBIT_EXTENSION_DISCOVERY_ATTRIBUTES_EXCLUDES_CONTROLLER="jenkins.controller.plugin.version".
+	DiscoveryAttributesExcludesController []string `json:"discoveryAttributesExcludesController" split_words:"true" required:"false"`
 }
 
 var (
@@ -39,5 +111,47 @@ func ParseConfiguration() {
 }
 
 func ValidateConfiguration() {
-	// You may optionally validate the configuration here.
+	var errs []error
+
+	baseUrl, err := url.Parse(Config.BaseUrl)
+	if err != nil || !baseUrl.IsAbs() || (baseUrl.Scheme != "http" && baseUrl.Scheme != "https") || baseUrl.Host == "" {
+		errs = append(errs, fmt.Errorf("BaseUrl must be an absolute http(s) URL, like 'https://ci.jenkins.io', but is '%s'", Config.BaseUrl))
+	} else if baseUrl.Scheme == "http" && Config.hasCredentials() {
+		log.Warn().Msgf("Jenkins at %s is accessed via plain http. Credentials are sent unencrypted.", Config.BaseUrl)
+	}
+
+	if Config.TracingOtlpEndpoint != "" {
+		if endpoint, err := url.Parse(Config.TracingOtlpEndpoint); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			errs = append(errs, fmt.Errorf("TracingOtlpEndpoint must be an absolute http(s) URL, like 'http://otel-collector:4318', but is '%s'", Config.TracingOtlpEndpoint))
+		}
+	}
+
+	if Config.JobStartTimeoutSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("JobStartTimeoutSeconds must be positive, but is %d", Config.JobStartTimeoutSeconds))
+	}
+	if Config.HttpTimeoutSeconds < 0 {
+		errs = append(errs, fmt.Errorf("HttpTimeoutSeconds must not be negative, but is %d", Config.HttpTimeoutSeconds))
+	}
+	if Config.HttpMaxIdleConnections < 0 {
+		errs = append(errs, fmt.Errorf("HttpMaxIdleConnections must not be negative, but is %d", Config.HttpMaxIdleConnections))
+	}
+
+	for _, pattern := range append(slices.Clone(Config.GuardrailsAllowedJobs), Config.GuardrailsDeniedJobs...) {
+		if _, err := regexp.Compile(AnchoredJobPattern(pattern)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid job pattern '%s' in guardrails: %w", pattern, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		log.Fatal().Err(errors.Join(errs...)).Msgf("Invalid configuration.")
+	}
+}
+
+func (s Specification) hasCredentials() bool {
+	return s.ApiToken != "" || s.ApiTokenFile != "" || s.OidcClientSecret != "" || s.OidcClientSecretFile != ""
+}
+
+// AnchoredJobPattern makes a job pattern of the guardrails match the full job name only.
+func AnchoredJobPattern(pattern string) string {
+	return "^(?:" + pattern + ")$"
 }
@@ -52,6 +52,18 @@ func TestWithMinikube(t *testing.T) {
 			Name: "run job",
 			Test: testRunJob,
 		},
+		{
+			Name: "run jobs",
+			Test: testRunJobs,
+		},
+		{
+			Name: "flood build queue",
+			Test: testFloodBuildQueue,
+		},
+		{
+			Name: "reduce executors",
+			Test: testReduceExecutors,
+		},
 	})
 }
 
@@ -102,3 +114,70 @@ func testRunJob(t *testing.T, m *e2e.Minikube, e *e2e.Extension) {
 	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Job started.", 60*time.Second)
 	require.NoError(t, exec.Cancel())
 }
+
+func testRunJobs(t *testing.T, m *e2e.Minikube, e *e2e.Extension) {
+	target := &action_kit_api.Target{
+		Attributes: map[string][]string{
+			"jenkins.job.name":      {"my-job"},
+			"jenkins.job.name.full": {"my-job"},
+		},
+	}
+	config := struct {
+		WaitForCompletion bool `json:"waitForCompletion"`
+	}{
+		WaitForCompletion: true,
+	}
+	context := &action_kit_api.ExecutionContext{ExperimentKey: new("ADM-1"), ExecutionId: new(4712)}
+	exec, err := e.RunAction("com.steadybit.extension_jenkins.job.run-many", target, config, context)
+	require.NoError(t, err)
+	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Job queued successfully.", 60*time.Second)
+	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Job started.", 60*time.Second)
+	require.NoError(t, exec.Cancel())
+}
+
+func testFloodBuildQueue(t *testing.T, m *e2e.Minikube, e *e2e.Extension) {
+	target := &action_kit_api.Target{
+		Attributes: map[string][]string{
+			"jenkins.job.name":      {"my-job"},
+			"jenkins.job.name.full": {"my-job"},
+			"jenkins.job.parameter": {"STEADYBIT_FLOOD_ID"},
+		},
+	}
+	config := struct {
+		Duration          int    `json:"duration"`
+		Count             int    `json:"count"`
+		Interval          int    `json:"interval"`
+		DistinctParameter string `json:"distinctParameter"`
+	}{
+		Duration:          60000,
+		Count:             3,
+		Interval:          0,
+		DistinctParameter: "STEADYBIT_FLOOD_ID",
+	}
+	context := &action_kit_api.ExecutionContext{ExperimentKey: new("ADM-1"), ExecutionId: new(4713)}
+	exec, err := e.RunAction("com.steadybit.extension_jenkins.job.flood-queue", target, config, context)
+	require.NoError(t, err)
+	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Builds queued.", 60*time.Second)
+	require.NoError(t, exec.Cancel())
+}
+
+func testReduceExecutors(t *testing.T, m *e2e.Minikube, e *e2e.Extension) {
+	target := &action_kit_api.Target{
+		Attributes: map[string][]string{
+			"jenkins.node.name": {"agent-1"},
+		},
+	}
+	config := struct {
+		Duration  int `json:"duration"`
+		Executors int `json:"executors"`
+	}{
+		Duration:  60000,
+		Executors: 0,
+	}
+	context := &action_kit_api.ExecutionContext{ExperimentKey: new("ADM-1"), ExecutionId: new(4714)}
+	exec, err := e.RunAction("com.steadybit.extension_jenkins.node.reduce-executors", target, config, context)
+	require.NoError(t, err)
+	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Reducing executors.", 60*time.Second)
+	require.NoError(t, exec.Cancel())
+	e2e.AssertLogContainsWithTimeout(t, m, e.Pod, "Restoring executors.", 60*time.Second)
+}
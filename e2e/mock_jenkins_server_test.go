@@ -43,18 +43,35 @@ func createMockJenkinsServer() *httptest.Server {
 					log.Info().Msg("Return buildWithParameters with Location header")
 					w.Header().Add("Location", baseURL+"/queue/item/20/") //NOSONAR gosecurity:S5146
 					w.WriteHeader(http.StatusOK)
+				} else if strings.HasSuffix(r.URL.Path, "/job/my-job/build/") && r.Method == http.MethodGet {
+					w.Header().Add("Allow", http.MethodPost)
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				} else if strings.HasSuffix(r.URL.Path, "/computer/agent-1/config.xml/") && r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`<?xml version="1.1" encoding="UTF-8"?><slave><name>agent-1</name><numExecutors>2</numExecutors><label>linux</label></slave>`))
+				} else if strings.HasSuffix(r.URL.Path, "/computer/agent-1/config.xml") && r.Method == http.MethodPost {
+					log.Info().Msg("Node configuration updated")
+					w.WriteHeader(http.StatusOK)
 				} else if strings.HasSuffix(r.URL.Path, "/job/Folder/job/Folder-project/api/json") {
 					w.WriteHeader(http.StatusOK)
 					w.Write(getJobInFolder(baseURL))
 				} else if strings.HasSuffix(r.URL.Path, "/queue/item/20/api/json") {
 					w.WriteHeader(http.StatusOK)
 					w.Write(getQueueItem(baseURL))
+				} else if strings.HasSuffix(r.URL.Path, "/whoAmI/api/json") {
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"_class": "hudson.security.WhoAmI","anonymous": false,"authenticated": true,"authorities": ["authenticated"],"name": "admin"}`))
+				} else if strings.HasSuffix(r.URL.Path, "/me/api/json") {
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"_class": "hudson.model.User","id": "admin","fullName": "admin"}`))
 				} else if strings.HasSuffix(r.URL.Path, "/api/json") && !strings.Contains(r.URL.Path, "/job") {
 					w.WriteHeader(http.StatusOK)
 					w.Write(getRoot(baseURL))
 				} else if strings.HasSuffix(r.URL.Path, "/job/my-job//9/api/json") {
 					w.WriteHeader(http.StatusOK)
 					w.Write(getBuild(baseURL))
+				} else if strings.HasSuffix(r.URL.Path, "/job/my-job//9/configSubmit") {
+					w.WriteHeader(http.StatusOK)
 				} else {
 					w.WriteHeader(http.StatusBadRequest)
 				}
@@ -411,7 +428,8 @@ func getRoot(baseURL string) []byte {
       "_class": "hudson.model.FreeStyleProject",
       "name": "my-job",
       "url": "%s/job/my-job/",
-      "color": "red"
+      "color": "red",
+      "buildable": true
     },
     {
       "_class": "com.cloudbees.hudson.plugins.folder.Folder",
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"fmt"
+	"sync"
+
+	extension_kit "github.com/steadybit/extension-kit"
+)
+
+// activeChanges tracks the Jenkins objects changed by running actions, which save the original value and restore it
+// when they end. A second action changing the same object meanwhile would save the already changed value as original
+// and restore that instead. The registry is kept in memory, so it does not cover changes made before a restart.
+var activeChanges = struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}{keys: map[string]bool{}}
+
+// claimChange registers a change of the object described by subject, like "cloud 'kubernetes'". It fails if another
+// action is changing the object.
+func claimChange(kind string, subject string) error {
+	activeChanges.mu.Lock()
+	defer activeChanges.mu.Unlock()
+	key := kind + "/" + subject
+	if activeChanges.keys[key] {
+		return extension_kit.ToError(fmt.Sprintf("Another step is already changing %s. Running both would restore the changed value when they end.", subject), nil)
+	}
+	activeChanges.keys[key] = true
+	return nil
+}
+
+// releaseChange unregisters a change once the original value is restored.
+func releaseChange(kind string, subject string) {
+	activeChanges.mu.Lock()
+	defer activeChanges.mu.Unlock()
+	delete(activeChanges.keys, kind+"/"+subject)
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/extension-jenkins/config"
+)
+
+// ValidateAttributeExcludes warns about excluded attributes, which are not known. Excludes may end with a wildcard.
+func ValidateAttributeExcludes() {
+	validateAttributeExcludes("job", config.Config.DiscoveryAttributesExcludesJob, jobAttributes)
+	validateAttributeExcludes("lockable resource", config.Config.DiscoveryAttributesExcludesLockableResource, lockableResourceAttributes)
+	validateAttributeExcludes("cloud", config.Config.DiscoveryAttributesExcludesCloud, cloudAttributes)
+	validateAttributeExcludes("pod template", config.Config.DiscoveryAttributesExcludesPodTemplate, podTemplateAttributes)
+	validateAttributeExcludes("node", config.Config.DiscoveryAttributesExcludesNode, nodeAttributes)
+	validateAttributeExcludes("view", config.Config.DiscoveryAttributesExcludesView, viewAttributes)
+	validateAttributeExcludes("build", config.Config.DiscoveryAttributesExcludesBuild, buildAttributes)
+	validateAttributeExcludes("controller", config.Config.DiscoveryAttributesExcludesController, controllerAttributes)
+}
+
+func validateAttributeExcludes(targetLabel string, excludes []string, knownAttributes []string) {
+	for _, exclude := range excludes {
+		if !slices.ContainsFunc(knownAttributes, func(attribute string) bool {
+			prefix, wildcard := strings.CutSuffix(exclude, "*")
+			return attribute == exclude || (wildcard && strings.HasPrefix(attribute, prefix))
+		}) {
+			log.Warn().Strs("knownAttributes", knownAttributes).Msgf("Excluded %s attribute '%s' is unknown and will have no effect.", targetLabel, exclude)
+		}
+	}
+}
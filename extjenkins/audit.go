@@ -0,0 +1,219 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/extension-jenkins/config"
+)
+
+const redactedValue = "*****"
+
+// Parameters with matching names are redacted, even if not declared as secret.
+var secretParameterName = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|api_?key|private_?key)`)
+
+// AuditRecord describes a single mutating request sent to Jenkins.
+type AuditRecord struct {
+	Timestamp     time.Time         `json:"timestamp"`
+	ExperimentKey string            `json:"experimentKey,omitempty"`
+	ExecutionId   int               `json:"executionId,omitempty"`
+	Jenkins       string            `json:"jenkins"`
+	Method        string            `json:"method"`
+	Endpoint      string            `json:"endpoint"`
+	Job           string            `json:"job,omitempty"`
+	Node          string            `json:"node,omitempty"`
+	Parameters    map[string]string `json:"parameters,omitempty"`
+	// PayloadSha256 is the digest of XML and JSON payloads, like node configurations, which may contain secrets.
+	PayloadSha256 string `json:"payloadSha256,omitempty"`
+	PayloadBytes  int    `json:"payloadBytes,omitempty"`
+	Outcome       string `json:"outcome"`
+	Status        int    `json:"status,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+var auditLog struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// InitAuditLog enables the audit log. Records are written as JSON lines to the file at the given path or to
+// 'stdout'. The log output of the extension is written to stderr, so the audit log cannot be written there.
+func InitAuditLog(target string) error {
+	var writer io.Writer
+	switch target {
+	case "stdout":
+		writer = os.Stdout
+	case "stderr":
+		return errors.New("the audit log cannot be written to stderr, which receives the log output, use a file or stdout")
+	default:
+		file, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open audit log: %w", err)
+		}
+		writer = file
+	}
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	auditLog.writer = writer
+	return nil
+}
+
+func writeAuditRecord(record AuditRecord) {
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	if auditLog.writer == nil {
+		return
+	}
+	if err := json.NewEncoder(auditLog.writer).Encode(record); err != nil {
+		log.Error().Err(err).Str("endpoint", record.Endpoint).Msg("Failed to write audit record.")
+	}
+}
+
+type auditSubjectKey struct{}
+
+// auditSubject is what mutating requests are sent for, passed in the context.
+type auditSubject struct {
+	correlation BuildCorrelation
+	job         string
+	node        string
+}
+
+type readOnlyKey struct{}
+
+// withReadOnly marks requests, which are sent by POST but do not change Jenkins, like scripts only reading the
+// configuration. These are not audited.
+func withReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func withAuditSubject(ctx context.Context, correlation BuildCorrelation, job string, node string) context.Context {
+	return context.WithValue(ctx, auditSubjectKey{}, auditSubject{correlation: correlation, job: job, node: node})
+}
+
+// auditingRequester decorates a gojenkins.JenkinsRequester and writes an audit record for every POST request.
+type auditingRequester struct {
+	gojenkins.JenkinsRequester
+}
+
+func NewAuditingRequester(delegate gojenkins.JenkinsRequester) gojenkins.JenkinsRequester {
+	return &auditingRequester{JenkinsRequester: delegate}
+}
+
+func (r *auditingRequester) Post(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string) (*http.Response, error) {
+	payload, form := readForm(payload)
+	resp, err := r.JenkinsRequester.Post(ctx, endpoint, payload, response, query)
+	audit(ctx, endpoint, form, nil, query, resp, err)
+	return resp, err
+}
+
+func (r *auditingRequester) PostXML(ctx context.Context, endpoint string, xml string, response interface{}, query map[string]string) (*http.Response, error) {
+	resp, err := r.JenkinsRequester.PostXML(ctx, endpoint, xml, response, query)
+	audit(ctx, endpoint, nil, []byte(xml), query, resp, err)
+	return resp, err
+}
+
+func (r *auditingRequester) PostJSON(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string) (*http.Response, error) {
+	payload, body := readPayload(payload)
+	resp, err := r.JenkinsRequester.PostJSON(ctx, endpoint, payload, response, query)
+	audit(ctx, endpoint, nil, body, query, resp, err)
+	return resp, err
+}
+
+func (r *auditingRequester) PostFiles(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string, files []string) (*http.Response, error) {
+	payload, form := readForm(payload)
+	resp, err := r.JenkinsRequester.PostFiles(ctx, endpoint, payload, response, query, files)
+	audit(ctx, endpoint, form, nil, query, resp, err)
+	return resp, err
+}
+
+// readForm reads the form values of a url-encoded payload and returns a reader replaying the payload.
+func readForm(payload io.Reader) (io.Reader, url.Values) {
+	payload, body := readPayload(payload)
+	if body == nil {
+		return payload, nil
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		form = nil
+	}
+	return payload, form
+}
+
+// readPayload reads the payload and returns a reader replaying it.
+func readPayload(payload io.Reader) (io.Reader, []byte) {
+	if payload == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(payload)
+	if err != nil {
+		return bytes.NewReader(body), nil
+	}
+	return bytes.NewReader(body), body
+}
+
+func audit(ctx context.Context, endpoint string, form url.Values, payload []byte, query map[string]string, response *http.Response, err error) {
+	if ctx.Value(readOnlyKey{}) != nil {
+		return
+	}
+	subject, _ := ctx.Value(auditSubjectKey{}).(auditSubject)
+	record := AuditRecord{
+		Timestamp:     time.Now().UTC(),
+		ExperimentKey: subject.correlation.ExperimentKey,
+		ExecutionId:   subject.correlation.ExecutionId,
+		Jenkins:       config.Config.BaseUrl,
+		Method:        http.MethodPost,
+		Endpoint:      endpoint,
+		Job:           subject.job,
+		Node:          subject.node,
+		Outcome:       "success",
+	}
+
+	parameters := make(map[string]string, len(form)+len(query))
+	for name, values := range form {
+		parameters[name] = strings.Join(values, ",")
+	}
+	for name, value := range query {
+		parameters[name] = value
+	}
+	if len(parameters) > 0 {
+		record.Parameters = redactParameters(parameters, secretParametersFromContext(ctx))
+	}
+	if len(payload) > 0 {
+		record.PayloadSha256 = fmt.Sprintf("%x", sha256.Sum256(payload))
+		record.PayloadBytes = len(payload)
+	}
+
+	if response != nil {
+		record.Status = response.StatusCode
+	}
+	// Jenkins answers most submissions with a redirect to an HTML page, which fails to decode although the request succeeded.
+	if response == nil || response.StatusCode >= http.StatusBadRequest {
+		record.Outcome = "failure"
+		if err != nil {
+			record.Error = err.Error()
+		}
+	}
+	writeAuditRecord(record)
+}
+
+func fullJobName(jobName string, parentIds []string) string {
+	return strings.Join(append(append([]string{}, parentIds...), jobName), "/")
+}
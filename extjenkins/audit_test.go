@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// payloadRecordingRequester answers every request with the given status and keeps the payloads it received.
+type payloadRecordingRequester struct {
+	gojenkins.JenkinsRequester
+	status   int
+	payloads []string
+}
+
+func (r *payloadRecordingRequester) answer(payload io.Reader) (*http.Response, error) {
+	if payload != nil {
+		body, _ := io.ReadAll(payload)
+		r.payloads = append(r.payloads, string(body))
+	}
+	return &http.Response{StatusCode: r.status, Body: http.NoBody}, nil
+}
+
+func (r *payloadRecordingRequester) Post(_ context.Context, _ string, payload io.Reader, _ interface{}, _ map[string]string) (*http.Response, error) {
+	return r.answer(payload)
+}
+
+func (r *payloadRecordingRequester) PostXML(_ context.Context, _ string, xml string, _ interface{}, _ map[string]string) (*http.Response, error) {
+	return r.answer(strings.NewReader(xml))
+}
+
+func (r *payloadRecordingRequester) PostJSON(_ context.Context, _ string, payload io.Reader, _ interface{}, _ map[string]string) (*http.Response, error) {
+	return r.answer(payload)
+}
+
+// captureAuditLog redirects the audit log into the returned buffer for the duration of the test.
+func captureAuditLog(t *testing.T) *bytes.Buffer {
+	var buffer bytes.Buffer
+	auditLog.writer = &buffer
+	t.Cleanup(func() { auditLog.writer = nil })
+	return &buffer
+}
+
+func readAuditRecords(t *testing.T, buffer *bytes.Buffer) []AuditRecord {
+	var records []AuditRecord
+	decoder := json.NewDecoder(buffer)
+	for decoder.More() {
+		var record AuditRecord
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestRedactParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters map[string]string
+		secrets    []string
+		want       map[string]string
+	}{
+		{name: "plain", parameters: map[string]string{"ENV": "staging"}, want: map[string]string{"ENV": "staging"}},
+		{name: "declared secret", parameters: map[string]string{"DEPLOY_KEY": "abc"}, secrets: []string{"DEPLOY_KEY"}, want: map[string]string{"DEPLOY_KEY": redactedValue}},
+		{name: "secret name", parameters: map[string]string{"API_TOKEN": "abc", "db_password": "abc", "ApiKey": "abc"}, want: map[string]string{"API_TOKEN": redactedValue, "db_password": redactedValue, "ApiKey": redactedValue}},
+		{name: "secret reference", parameters: map[string]string{"TARGET": "${secret:target}"}, want: map[string]string{"TARGET": redactedValue}},
+		{name: "empty", parameters: map[string]string{}, want: map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactParameters(tt.parameters, tt.secrets))
+		})
+	}
+}
+
+func TestAuditingRequesterRedactsForms(t *testing.T) {
+	buffer := captureAuditLog(t)
+	delegate := &payloadRecordingRequester{status: http.StatusCreated}
+	requester := NewAuditingRequester(delegate)
+	ctx := withAuditSubject(t.Context(), BuildCorrelation{ExperimentKey: "ADM-1", ExecutionId: 4711}, "team/deploy", "")
+	ctx = withSecretParameters(ctx, []string{"SSH_KEY"})
+	form := url.Values{"ENV": {"staging"}, "SSH_KEY": {"-----BEGIN"}, "API_TOKEN": {"abc"}}
+
+	_, err := requester.Post(ctx, "/job/team/job/deploy/buildWithParameters", strings.NewReader(form.Encode()), nil, map[string]string{"delay": "0sec"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{form.Encode()}, delegate.payloads, "the payload is passed on unchanged")
+	records := readAuditRecords(t, buffer)
+	require.Len(t, records, 1)
+	assert.Equal(t, "ADM-1", records[0].ExperimentKey)
+	assert.Equal(t, 4711, records[0].ExecutionId)
+	assert.Equal(t, "team/deploy", records[0].Job)
+	assert.Equal(t, "success", records[0].Outcome)
+	assert.Equal(t, http.StatusCreated, records[0].Status)
+	assert.Equal(t, map[string]string{"ENV": "staging", "SSH_KEY": redactedValue, "API_TOKEN": redactedValue, "delay": "0sec"}, records[0].Parameters)
+	assert.Empty(t, records[0].PayloadSha256)
+}
+
+func TestAuditingRequesterRecordsPayloadDigests(t *testing.T) {
+	xml := "<slave><numExecutors>1</numExecutors><password>secret</password></slave>"
+	json := `{"token":"secret"}`
+	buffer := captureAuditLog(t)
+	delegate := &payloadRecordingRequester{status: http.StatusOK}
+	requester := NewAuditingRequester(delegate)
+	ctx := withAuditSubject(t.Context(), BuildCorrelation{}, "", "agent-1")
+
+	_, err := requester.PostXML(ctx, "/computer/agent-1/config.xml", xml, nil, nil)
+	require.NoError(t, err)
+	_, err = requester.PostJSON(ctx, "/manage/configuration-as-code/apply", strings.NewReader(json), nil, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{xml, json}, delegate.payloads, "the payloads are passed on unchanged")
+	records := readAuditRecords(t, buffer)
+	require.Len(t, records, 2)
+	assert.Equal(t, "agent-1", records[0].Node)
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(xml))), records[0].PayloadSha256)
+	assert.Equal(t, len(xml), records[0].PayloadBytes)
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(json))), records[1].PayloadSha256)
+	assert.NotContains(t, buffer.String(), "secret")
+}
+
+func TestAuditingRequesterRecordsFailures(t *testing.T) {
+	buffer := captureAuditLog(t)
+	requester := NewAuditingRequester(&payloadRecordingRequester{status: http.StatusForbidden})
+
+	_, _ = requester.Post(t.Context(), "/job/deploy/build", nil, nil, nil)
+
+	records := readAuditRecords(t, buffer)
+	require.Len(t, records, 1)
+	assert.Equal(t, "failure", records[0].Outcome)
+	assert.Equal(t, http.StatusForbidden, records[0].Status)
+}
+
+func TestAuditingRequesterSkipsReadOnlyRequests(t *testing.T) {
+	buffer := captureAuditLog(t)
+	requester := NewAuditingRequester(&payloadRecordingRequester{status: http.StatusOK})
+
+	_, _ = requester.Post(withReadOnly(t.Context()), "/scriptText", strings.NewReader("script=println(1)"), nil, nil)
+
+	assert.Empty(t, buffer.String())
+}
+
+func TestInitAuditLog(t *testing.T) {
+	t.Cleanup(func() { auditLog.writer = nil })
+
+	assert.ErrorContains(t, InitAuditLog("stderr"), "cannot be written to stderr")
+	assert.NoError(t, InitAuditLog("stdout"))
+	assert.NoError(t, InitAuditLog(filepath.Join(t.TempDir(), "audit.log")))
+	assert.Error(t, InitAuditLog(filepath.Join(t.TempDir(), "missing", "audit.log")))
+}
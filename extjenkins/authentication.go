@@ -0,0 +1,280 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/extension-jenkins/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	AuthModeBasic  = "basic"
+	AuthModeBearer = "bearer"
+	AuthModeOidc   = "oidc"
+
+	// Access tokens are refreshed this long before they expire.
+	accessTokenExpiryLeeway = 30 * time.Second
+	// Access tokens without expires_in are cached this long.
+	accessTokenDefaultLifetime = 5 * time.Minute
+)
+
+// AuthenticationError is returned if no credentials for a Jenkins request could be obtained.
+type AuthenticationError struct {
+	Cause error
+}
+
+func (e *AuthenticationError) Error() string {
+	return fmt.Sprintf("failed to authenticate: %s", e.Cause)
+}
+
+func (e *AuthenticationError) Unwrap() error {
+	return e.Cause
+}
+
+// authenticator adds the credentials to requests sent to Jenkins.
+type authenticator interface {
+	authenticate(request *http.Request) error
+}
+
+// invalidatingAuthenticator is implemented by authenticators caching credentials, which are discarded once
+// Jenkins rejects them.
+type invalidatingAuthenticator interface {
+	invalidate()
+}
+
+// newAuthenticator creates the authenticator for the configured auth mode. The client is used to request
+// access tokens.
+func newAuthenticator(client *http.Client) (authenticator, error) {
+	switch strings.ToLower(config.Config.AuthMode) {
+	case AuthModeBasic, "":
+		if config.Config.ApiUser == "" {
+			return nil, errors.New("API user is required for basic authentication")
+		}
+		token, err := newSecret("API token", config.Config.ApiToken, config.Config.ApiTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		return &basicAuthenticator{user: config.Config.ApiUser, token: token}, nil
+	case AuthModeBearer:
+		token, err := newSecret("API token", config.Config.ApiToken, config.Config.ApiTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		return &bearerAuthenticator{token: token}, nil
+	case AuthModeOidc:
+		if config.Config.OidcTokenUrl == "" || config.Config.OidcClientId == "" {
+			return nil, errors.New("OIDC token URL and client id are required for OIDC authentication")
+		}
+		clientSecret, err := newSecret("OIDC client secret", config.Config.OidcClientSecret, config.Config.OidcClientSecretFile)
+		if err != nil {
+			return nil, err
+		}
+		return &clientCredentialsAuthenticator{
+			client:       client,
+			tokenUrl:     config.Config.OidcTokenUrl,
+			clientId:     config.Config.OidcClientId,
+			clientSecret: clientSecret,
+			scopes:       config.Config.OidcScopes,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode '%s', expected one of %s, %s or %s", config.Config.AuthMode, AuthModeBasic, AuthModeBearer, AuthModeOidc)
+	}
+}
+
+// authenticatingTransport adds the credentials of the authenticator to every request.
+type authenticatingTransport struct {
+	delegate      http.RoundTripper
+	authenticator authenticator
+}
+
+func (t *authenticatingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	if err := t.authenticator.authenticate(request); err != nil {
+		return nil, &AuthenticationError{Cause: err}
+	}
+	response, err := t.delegate.RoundTrip(request)
+	if response != nil && response.StatusCode == http.StatusUnauthorized {
+		if invalidating, ok := t.authenticator.(invalidatingAuthenticator); ok {
+			invalidating.invalidate()
+		}
+	}
+	return response, err
+}
+
+func (t *authenticatingTransport) CloseIdleConnections() {
+	if closer, ok := t.delegate.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+type basicAuthenticator struct {
+	user  string
+	token *secret
+}
+
+func (a *basicAuthenticator) authenticate(request *http.Request) error {
+	token, err := a.token.get()
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth(a.user, token)
+	return nil
+}
+
+type bearerAuthenticator struct {
+	token *secret
+}
+
+func (a *bearerAuthenticator) authenticate(request *http.Request) error {
+	token, err := a.token.get()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// clientCredentialsAuthenticator obtains access tokens through the OAuth2 client credentials flow and refreshes them
+// before they expire. A new token source is created whenever the client secret changes or Jenkins rejects a token.
+type clientCredentialsAuthenticator struct {
+	client       *http.Client
+	tokenUrl     string
+	clientId     string
+	clientSecret *secret
+	scopes       []string
+
+	mu           sync.Mutex
+	source       oauth2.TokenSource
+	sourceSecret string
+}
+
+func (a *clientCredentialsAuthenticator) authenticate(request *http.Request) error {
+	token, err := a.tokenSource()
+	if err != nil {
+		return err
+	}
+	accessToken, err := token.Token()
+	if err != nil {
+		return fmt.Errorf("failed to request access token: %w", err)
+	}
+	accessToken.SetAuthHeader(request)
+	return nil
+}
+
+func (a *clientCredentialsAuthenticator) invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.source = nil
+}
+
+func (a *clientCredentialsAuthenticator) tokenSource() (oauth2.TokenSource, error) {
+	clientSecret, err := a.clientSecret.get()
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.source != nil && a.sourceSecret == clientSecret {
+		return a.source, nil
+	}
+
+	credentials := &clientcredentials.Config{
+		ClientID:     a.clientId,
+		ClientSecret: clientSecret,
+		TokenURL:     a.tokenUrl,
+		Scopes:       a.scopes,
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, a.client)
+	a.source = oauth2.ReuseTokenSourceWithExpiry(nil, withDefaultLifetime{credentials.TokenSource(ctx)}, accessTokenExpiryLeeway)
+	a.sourceSecret = clientSecret
+	return a.source, nil
+}
+
+// withDefaultLifetime limits the lifetime of access tokens issued without expires_in, which would be used forever
+// otherwise.
+type withDefaultLifetime struct {
+	delegate oauth2.TokenSource
+}
+
+func (s withDefaultLifetime) Token() (*oauth2.Token, error) {
+	token, err := s.delegate.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token.Expiry.IsZero() {
+		token.Expiry = time.Now().Add(accessTokenDefaultLifetime)
+	}
+	log.Debug().Time("expiry", token.Expiry).Msg("Obtained access token for Jenkins.")
+	return token, nil
+}
+
+// secret is a credential configured either as value or as file. Files take precedence and are re-read when they
+// change, so rotated credentials are used without restarting the extension.
+type secret struct {
+	name string
+	file string
+
+	mu      sync.Mutex
+	value   string
+	modTime time.Time
+}
+
+func newSecret(name, value, file string) (*secret, error) {
+	if value == "" && file == "" {
+		return nil, fmt.Errorf("%s is required", name)
+	}
+	s := &secret{name: name, value: value, file: file}
+	if _, err := s.get(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *secret) get() (string, error) {
+	if s.file == "" {
+		return s.value, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat, err := os.Stat(s.file)
+	if err != nil {
+		if !s.modTime.IsZero() {
+			log.Warn().Err(err).Msgf("Failed to check %s file for changes. Keeping the current value.", s.name)
+			return s.value, nil
+		}
+		return "", fmt.Errorf("failed to read %s file: %w", s.name, err)
+	}
+	if !s.modTime.IsZero() && !stat.ModTime().After(s.modTime) {
+		return s.value, nil
+	}
+
+	content, err := os.ReadFile(s.file)
+	if err != nil {
+		if !s.modTime.IsZero() {
+			log.Warn().Err(err).Msgf("Failed to reload %s file. Keeping the current value.", s.name)
+			return s.value, nil
+		}
+		return "", fmt.Errorf("failed to read %s file: %w", s.name, err)
+	}
+	if !s.modTime.IsZero() {
+		log.Info().Str("file", s.file).Msgf("Reloaded %s.", s.name)
+	}
+	s.value = strings.TrimSpace(string(content))
+	s.modTime = stat.ModTime()
+	return s.value, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenServer issues numbered access tokens for the client secret "s3cret" and "rotated".
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	var issued atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "steadybit", r.PostForm.Get("client_id"))
+		assert.Equal(t, "jenkins", r.PostForm.Get("scope"))
+		if secret := r.PostForm.Get("client_secret"); secret != "s3cret" && secret != "rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, issued.Add(1), expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &issued
+}
+
+func newTestClientCredentialsAuthenticator(server *httptest.Server, clientSecret *secret) *clientCredentialsAuthenticator {
+	return &clientCredentialsAuthenticator{
+		client:       server.Client(),
+		tokenUrl:     server.URL,
+		clientId:     "steadybit",
+		clientSecret: clientSecret,
+		scopes:       []string{"jenkins"},
+	}
+}
+
+func authorizationOf(t *testing.T, a authenticator) string {
+	request := httptest.NewRequest(http.MethodGet, "http://jenkins/api/json", nil)
+	require.NoError(t, a.authenticate(request))
+	return request.Header.Get("Authorization")
+}
+
+func TestClientCredentialsAuthenticator(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiresIn  int
+		wantIssued int32
+	}{
+		{name: "caches valid token", expiresIn: 3600, wantIssued: 1},
+		{name: "caches token without expiry", expiresIn: 0, wantIssued: 1},
+		{name: "refreshes token expiring within leeway", expiresIn: 10, wantIssued: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, issued := newTokenServer(t, tt.expiresIn)
+			a := newTestClientCredentialsAuthenticator(server, &secret{name: "OIDC client secret", value: "s3cret"})
+
+			for range 3 {
+				assert.Regexp(t, `^Bearer token-\d$`, authorizationOf(t, a))
+			}
+			assert.Equal(t, tt.wantIssued, issued.Load())
+		})
+	}
+}
+
+func TestClientCredentialsAuthenticatorInvalidate(t *testing.T) {
+	server, issued := newTokenServer(t, 3600)
+	a := newTestClientCredentialsAuthenticator(server, &secret{name: "OIDC client secret", value: "s3cret"})
+
+	assert.Equal(t, "Bearer token-1", authorizationOf(t, a))
+	a.invalidate()
+	assert.Equal(t, "Bearer token-2", authorizationOf(t, a))
+	assert.Equal(t, int32(2), issued.Load())
+}
+
+func TestClientCredentialsAuthenticatorRotatedSecret(t *testing.T) {
+	server, _ := newTokenServer(t, 3600)
+	file := filepath.Join(t.TempDir(), "client-secret")
+	require.NoError(t, os.WriteFile(file, []byte("s3cret\n"), 0o600))
+	clientSecret, err := newSecret("OIDC client secret", "", file)
+	require.NoError(t, err)
+	a := newTestClientCredentialsAuthenticator(server, clientSecret)
+
+	assert.Equal(t, "Bearer token-1", authorizationOf(t, a))
+
+	require.NoError(t, os.WriteFile(file, []byte("rotated"), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(file, later, later))
+	assert.Equal(t, "Bearer token-2", authorizationOf(t, a))
+}
+
+func TestClientCredentialsAuthenticatorRejectedSecret(t *testing.T) {
+	server, _ := newTokenServer(t, 3600)
+	a := newTestClientCredentialsAuthenticator(server, &secret{name: "OIDC client secret", value: "wrong"})
+
+	err := a.authenticate(httptest.NewRequest(http.MethodGet, "http://jenkins/api/json", nil))
+
+	assert.ErrorContains(t, err, "failed to request access token")
+}
+
+func TestAuthenticatingTransportInvalidatesRejectedToken(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+	a := newTestClientCredentialsAuthenticator(tokenServer, &secret{name: "OIDC client secret", value: "s3cret"})
+	jenkins := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer jenkins.Close()
+	client := &http.Client{Transport: &authenticatingTransport{delegate: http.DefaultTransport, authenticator: a}}
+
+	for _, wantStatus := range []int{http.StatusUnauthorized, http.StatusOK} {
+		response, err := client.Get(jenkins.URL)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		assert.Equal(t, wantStatus, response.StatusCode)
+	}
+	assert.Equal(t, int32(2), issued.Load())
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+)
+
+const (
+	// Jobs defining build parameters with these names receive the experiment key and execution id of the triggering experiment.
+	parameterExperimentKey = "STEADYBIT_EXPERIMENT_KEY"
+	parameterExecutionId   = "STEADYBIT_EXECUTION_ID"
+)
+
+// BuildCorrelation identifies the experiment execution that triggered a build.
+type BuildCorrelation struct {
+	ExperimentKey string
+	ExecutionId   int
+}
+
+func newBuildCorrelation(executionContext *action_kit_api.ExecutionContext) BuildCorrelation {
+	correlation := BuildCorrelation{}
+	if executionContext == nil {
+		return correlation
+	}
+	if executionContext.ExperimentKey != nil {
+		correlation.ExperimentKey = *executionContext.ExperimentKey
+	}
+	if executionContext.ExecutionId != nil {
+		correlation.ExecutionId = *executionContext.ExecutionId
+	}
+	return correlation
+}
+
+func (c BuildCorrelation) isEmpty() bool {
+	return c.ExperimentKey == "" && c.ExecutionId == 0
+}
+
+// Label is shown as build description and display name, like "Steadybit ADM-1 #4711".
+func (c BuildCorrelation) Label() string {
+	return fmt.Sprintf("Steadybit %s #%d", c.ExperimentKey, c.ExecutionId)
+}
+
+// withParameters returns the build parameters extended by the correlation parameters the job accepts.
+// Parameters given by the user take precedence.
+func (c BuildCorrelation) withParameters(job *gojenkins.Job, parameters map[string]string) map[string]string {
+	if c.isEmpty() {
+		return parameters
+	}
+	result := make(map[string]string, len(parameters)+2)
+	for _, property := range job.Raw.Property {
+		for _, definition := range property.ParameterDefinitions {
+			switch definition.Name {
+			case parameterExperimentKey:
+				result[parameterExperimentKey] = c.ExperimentKey
+			case parameterExecutionId:
+				result[parameterExecutionId] = strconv.Itoa(c.ExecutionId)
+			}
+		}
+	}
+	maps.Copy(result, parameters)
+	return result
+}
+
+// annotate sets the description and display name of a started build. Failures are only logged, as missing
+// Run/Update permissions must not fail the experiment.
+func (c BuildCorrelation) annotate(ctx context.Context, build *gojenkins.Build) {
+	if c.isEmpty() {
+		return
+	}
+	displayName := fmt.Sprintf("#%d %s", build.Raw.Number, c.Label())
+	config, err := json.Marshal(map[string]string{
+		"displayName": displayName,
+		"description": c.Label(),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to encode build annotation.")
+		return
+	}
+	if _, err = postForm(ctx, build.Jenkins, build.Base+"/configSubmit", url.Values{"json": {string(config)}}, nil); err != nil {
+		log.Warn().Err(err).Int64("runId", build.Raw.Number).Msg("Failed to set build description and display name.")
+		return
+	}
+	log.Debug().Int64("runId", build.Raw.Number).Str("displayName", displayName).Msg("Build annotated.")
+}
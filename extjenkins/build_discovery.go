@@ -0,0 +1,327 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+const executableTree = "currentExecutable[url,number,fullDisplayName,timestamp,estimatedDuration,actions[causes[shortDescription]]]"
+
+// executable is a build currently executed by an executor.
+type executable struct {
+	Url             string `json:"url"`
+	Number          int64  `json:"number"`
+	FullDisplayName string `json:"fullDisplayName"`
+	// Timestamp is the start of the build in milliseconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+	// EstimatedDuration is in milliseconds, -1 if unknown.
+	EstimatedDuration int64 `json:"estimatedDuration"`
+	Actions           []struct {
+		Causes []struct {
+			ShortDescription string `json:"shortDescription"`
+		} `json:"causes"`
+	} `json:"actions"`
+}
+
+type executor struct {
+	CurrentExecutable *executable `json:"currentExecutable"`
+}
+
+// runningBuild is a build being executed and the nodes it runs on.
+type runningBuild struct {
+	executable
+	Nodes []string
+}
+
+// getRunningBuilds lists the builds currently executed by any executor. Pipeline builds run on a flyweight executor
+// outside the nodes, their node steps occupy regular executors of the nodes with placeholder executables. These are
+// attributed to their Pipeline build, so the build carries the nodes running its steps.
+func getRunningBuilds(ctx context.Context, jenkins *gojenkins.Jenkins) ([]runningBuild, error) {
+	var response struct {
+		Computer []struct {
+			Class           string     `json:"_class"`
+			DisplayName     string     `json:"displayName"`
+			Executors       []executor `json:"executors"`
+			OneOffExecutors []executor `json:"oneOffExecutors"`
+		} `json:"computer"`
+	}
+	query := map[string]string{"tree": "computer[_class,displayName,executors[" + executableTree + "],oneOffExecutors[" + executableTree + "]]"}
+	if _, err := jenkins.Requester.GetJSON(ctx, "/computer", &response, query); err != nil {
+		return nil, err
+	}
+
+	var (
+		builds       []runningBuild
+		placeholders []runningBuild
+	)
+	indexes := make(map[string]int)
+	addNode := func(i int, node string) {
+		if node != "" && !slices.Contains(builds[i].Nodes, node) {
+			builds[i].Nodes = append(builds[i].Nodes, node)
+		}
+	}
+	add := func(executor executor, node string) {
+		if executor.CurrentExecutable == nil {
+			return
+		}
+		// Placeholder executables of Pipeline node steps do not export the url of their build.
+		if executor.CurrentExecutable.Url == "" {
+			placeholders = append(placeholders, runningBuild{executable: *executor.CurrentExecutable, Nodes: []string{node}})
+			return
+		}
+		buildUrl := resolveBuildUrl(jenkins, executor.CurrentExecutable.Url)
+		i, ok := indexes[buildUrl]
+		if !ok {
+			i = len(builds)
+			indexes[buildUrl] = i
+			builds = append(builds, runningBuild{executable: *executor.CurrentExecutable})
+			builds[i].Url = buildUrl
+		}
+		addNode(i, node)
+	}
+	for _, computer := range response.Computer {
+		// Nodes are named like the node targets, which address the built-in node as '(built-in)'.
+		node := computer.DisplayName
+		if computer.Class == builtInComputerClass {
+			node = builtInNodeName
+		}
+		for _, executor := range computer.Executors {
+			add(executor, node)
+		}
+		for _, executor := range computer.OneOffExecutors {
+			add(executor, "")
+		}
+	}
+	for _, placeholder := range placeholders {
+		// The longest name wins, so 'part of team » pipeline #2' belongs to 'team » pipeline #2' and not 'pipeline #2'.
+		owner := -1
+		for i, build := range builds {
+			if placeholder.isPartOf(build) && (owner < 0 || len(build.FullDisplayName) > len(builds[owner].FullDisplayName)) {
+				owner = i
+			}
+		}
+		if owner >= 0 {
+			addNode(owner, placeholder.Nodes[0])
+		}
+	}
+	return builds, nil
+}
+
+// isPartOf reports whether the placeholder executable of a Pipeline node step belongs to the given build. The
+// placeholder carries the number of its build and is named after it, like 'part of folder » pipeline #12'.
+func (b runningBuild) isPartOf(build runningBuild) bool {
+	if b.Number == 0 || b.Number != build.Number || build.FullDisplayName == "" {
+		return false
+	}
+	return b.FullDisplayName == build.FullDisplayName || strings.HasSuffix(b.FullDisplayName, " "+build.FullDisplayName)
+}
+
+// resolveBuildUrl makes the url of an executable absolute, like the urls Jenkins exports for builds, so executables
+// with a url relative to the root of Jenkins are merged with their build.
+func resolveBuildUrl(jenkins *gojenkins.Jenkins, buildUrl string) string {
+	parsed, err := url.Parse(buildUrl)
+	if err != nil || parsed.IsAbs() {
+		return buildUrl
+	}
+	return strings.TrimSuffix(jenkins.Server, "/") + "/" + strings.TrimPrefix(buildUrl, "/")
+}
+
+// jobFullNameFromBuildUrl extracts the full name of the job from the url of one of its builds, like
+// 'https://jenkins/job/folder/job/name/12/'.
+func jobFullNameFromBuildUrl(buildUrl string) string {
+	parsed, err := url.Parse(buildUrl)
+	if err != nil {
+		return ""
+	}
+	// The escaped path keeps names apart, which contain encoded slashes like the branches of multibranch projects.
+	segments := strings.Split(strings.Trim(parsed.EscapedPath(), "/"), "/")
+	var names []string
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "job" {
+			name, err := url.PathUnescape(segments[i+1])
+			if err != nil {
+				name = segments[i+1]
+			}
+			names = append(names, name)
+			i++
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+type buildDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*buildDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*buildDiscovery)(nil)
+)
+
+// NewBuildDiscovery discovers running builds. Builds are short-lived, so they are discovered every few seconds.
+func NewBuildDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &buildDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 10*time.Second),
+	)
+}
+
+func (d *buildDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeBuild,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("10s"),
+		},
+	}
+}
+
+func (d *buildDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeBuild,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconBuild),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Build", Other: "Jenkins Builds"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.job.name.full"},
+				{Attribute: "jenkins.build.number"},
+				{Attribute: "jenkins.build.node"},
+				{Attribute: "jenkins.build.start-time"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.build.start-time",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *buildDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.build.number",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build number",
+				Other: "Build numbers",
+			},
+		},
+		{
+			Attribute: "jenkins.build.url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build url",
+				Other: "Build urls",
+			},
+		},
+		{
+			Attribute: "jenkins.build.node",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build node",
+				Other: "Build nodes",
+			},
+		},
+		{
+			Attribute: "jenkins.build.start-time",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build start time",
+				Other: "Build start times",
+			},
+		},
+		{
+			Attribute: "jenkins.build.estimated-duration",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build estimated duration (seconds)",
+				Other: "Build estimated durations (seconds)",
+			},
+		},
+		{
+			Attribute: "jenkins.build.cause",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build cause",
+				Other: "Build causes",
+			},
+		},
+	}
+}
+
+// buildAttributes lists all attributes of build targets, which may be excluded through DiscoveryAttributesExcludesBuild.
+var buildAttributes = []string{
+	"jenkins.build.number",
+	"jenkins.build.url",
+	"jenkins.build.node",
+	"jenkins.build.start-time",
+	"jenkins.build.estimated-duration",
+	"jenkins.build.cause",
+	"jenkins.job.name",
+	"jenkins.job.name.full",
+}
+
+func (d *buildDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.builds", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	builds, err := getRunningBuilds(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeBuild, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch running builds.", err)
+	}
+
+	targets := make([]discovery_kit_api.Target, len(builds))
+	for i, build := range builds {
+		fullName := jobFullNameFromBuildUrl(build.Url)
+		targets[i] = discovery_kit_api.Target{
+			Id:         build.Url,
+			TargetType: TargetTypeBuild,
+			Label:      build.FullDisplayName,
+			Attributes: map[string][]string{
+				"jenkins.build.number":     {strconv.FormatInt(build.Number, 10)},
+				"jenkins.build.url":        {build.Url},
+				"jenkins.build.start-time": {time.UnixMilli(build.Timestamp).UTC().Format(time.RFC3339)},
+				"jenkins.job.name":         {fullName[strings.LastIndex(fullName, "/")+1:]},
+				"jenkins.job.name.full":    {fullName},
+			},
+		}
+		if len(build.Nodes) > 0 {
+			targets[i].Attributes["jenkins.build.node"] = build.Nodes
+		}
+		if build.EstimatedDuration >= 0 {
+			targets[i].Attributes["jenkins.build.estimated-duration"] = []string{strconv.FormatInt(build.EstimatedDuration/1000, 10)}
+		}
+		var causes []string
+		for _, action := range build.Actions {
+			for _, cause := range action.Causes {
+				if !slices.Contains(causes, cause.ShortDescription) {
+					causes = append(causes, cause.ShortDescription)
+				}
+			}
+		}
+		if len(causes) > 0 {
+			targets[i].Attributes["jenkins.build.cause"] = causes
+		}
+	}
+	observeDiscovery(TargetTypeBuild, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesBuild), nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobFullNameFromBuildUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		buildUrl string
+		want     string
+	}{
+		{name: "job", buildUrl: "https://jenkins/job/deploy/12/", want: "deploy"},
+		{name: "folder", buildUrl: "https://jenkins/job/team/job/deploy/12/", want: "team/deploy"},
+		{name: "base path", buildUrl: "https://example.com/jenkins/job/team/job/deploy/12/", want: "team/deploy"},
+		{name: "escaped name", buildUrl: "https://jenkins/job/my%20job/3/", want: "my job"},
+		{name: "job named job", buildUrl: "https://jenkins/job/job/job/job/1/", want: "job/job"},
+		{name: "multibranch", buildUrl: "https://jenkins/job/app/job/feature%252Flogin/7/", want: "app/feature%2Flogin"},
+		{name: "no job", buildUrl: "https://jenkins/computer/agent-1/", want: ""},
+		{name: "invalid url", buildUrl: "://jenkins", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, jobFullNameFromBuildUrl(tt.buildUrl))
+		})
+	}
+}
+
+func TestGetRunningBuilds(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/computer/api/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"computer":[
+		  {"_class":"hudson.model.Hudson$MasterComputer","displayName":"Built-In Node",
+		   "executors":[{"currentExecutable":{"url":"https://jenkins/job/deploy/1/","number":1}},{"currentExecutable":null}],
+		   "oneOffExecutors":[
+		     {"currentExecutable":{"url":"https://jenkins/job/pipeline/2/","number":2,"fullDisplayName":"pipeline #2"}},
+		     {"currentExecutable":{"url":"https://jenkins/job/team/job/pipeline/2/","number":2,"fullDisplayName":"team » pipeline #2"}},
+		     {"currentExecutable":{"url":"` + server.URL + `/job/idle/3/","number":3,"fullDisplayName":"idle #3"}}]},
+		  {"_class":"hudson.slaves.SlaveComputer","displayName":"agent-1",
+		   "executors":[{"currentExecutable":{"url":"https://jenkins/job/deploy/1/","number":1}},
+		     {"currentExecutable":{"number":2,"fullDisplayName":"part of pipeline #2"}}]},
+		  {"_class":"hudson.slaves.SlaveComputer","displayName":"agent-2",
+		   "executors":[{"currentExecutable":{"number":2,"fullDisplayName":"part of team » pipeline #2"}},
+		     {"currentExecutable":{"url":"job/idle/3/","number":3,"fullDisplayName":"idle #3"}},
+		     {"currentExecutable":{"number":4,"fullDisplayName":"part of gone #4"}}]}]}`))
+	}))
+	defer server.Close()
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+
+	builds, err := getRunningBuilds(t.Context(), jenkins)
+
+	require.NoError(t, err)
+	require.Len(t, builds, 4)
+	assert.Equal(t, "https://jenkins/job/deploy/1/", builds[0].Url)
+	assert.Equal(t, []string{builtInNodeName, "agent-1"}, builds[0].Nodes, "the built-in node is named like its node target")
+	assert.Equal(t, "https://jenkins/job/pipeline/2/", builds[1].Url)
+	assert.Equal(t, []string{"agent-1"}, builds[1].Nodes, "node steps of Pipeline builds are attributed to their build")
+	assert.Equal(t, "https://jenkins/job/team/job/pipeline/2/", builds[2].Url)
+	assert.Equal(t, []string{"agent-2"}, builds[2].Nodes)
+	assert.Equal(t, server.URL+"/job/idle/3/", builds[3].Url)
+	assert.Equal(t, []string{"agent-2"}, builds[3].Nodes, "relative urls are resolved against the root of Jenkins")
+}
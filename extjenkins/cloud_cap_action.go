@@ -0,0 +1,286 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const (
+	cloudCapActionId       = TargetTypeCloud + ".limit-cap"
+	podTemplateCapActionId = TargetTypePodTemplate + ".limit-cap"
+
+	restoreViaScript = "script"
+	restoreViaCasc   = "casc"
+)
+
+// setCapScript sets the container cap of a Kubernetes cloud, the instance cap of other clouds or the instance cap of
+// a pod template, and returns the previous value. The field is written directly, as the Kubernetes plugin treats a
+// container cap of 0 set through its setter as unlimited. A previous value of null means unlimited.
+const setCapScript = `
+    def instance = jenkins.model.Jenkins.get()
+    def cloud = instance.clouds.getByName(%s)
+    if (cloud == null) throw new IllegalArgumentException('Cloud not found')
+    def target = cloud
+    def templateName = %s
+    if (templateName != null) {
+      target = cloud.templates.find { template -> template.name == templateName }
+      if (target == null) throw new IllegalArgumentException('Pod template not found')
+    }
+    def property = target.metaClass.hasProperty(target, 'containerCap') != null ? 'containerCap' : 'instanceCap'
+    def previous = target.@"$property"
+    target.@"$property" = %s
+    instance.save()
+    return previous`
+
+type cloudCapAction struct {
+	jenkins    *gojenkins.Jenkins
+	targetType string
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[CloudCapActionState]         = (*cloudCapAction)(nil)
+	_ action_kit_sdk.ActionWithStop[CloudCapActionState] = (*cloudCapAction)(nil)
+)
+
+type CloudCapActionState struct {
+	CloudName string
+	// TemplateName is empty if the cap of the cloud is limited.
+	TemplateName string
+	Cap          int
+	Restore      string
+	// OriginalCap is the cap before the action, nil if unlimited. It is only valid if Applied is set.
+	OriginalCap *int
+	Applied     bool
+	Correlation BuildCorrelation
+}
+
+func NewCloudCapAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[CloudCapActionState] {
+	return &cloudCapAction{jenkins: jenkins, targetType: TargetTypeCloud}
+}
+
+func NewPodTemplateCapAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[CloudCapActionState] {
+	return &cloudCapAction{jenkins: jenkins, targetType: TargetTypePodTemplate}
+}
+
+func (a *cloudCapAction) NewEmptyState() CloudCapActionState {
+	return CloudCapActionState{}
+}
+
+func (a *cloudCapAction) Describe() action_kit_api.ActionDescription {
+	description := action_kit_api.ActionDescription{
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long the cap is limited."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "cap",
+				Label:        "Cap",
+				Description:  new("Maximum number of agents to provision. With 0, no new agents are provisioned."),
+				Type:         action_kit_api.ActionParameterTypeInteger,
+				DefaultValue: new("0"),
+				MinValue:     new(0),
+				Required:     new(true),
+			},
+			{
+				Name:         "restore",
+				Label:        "Restore",
+				Description:  new("How to restore the original cap. If Jenkins is managed by Configuration as Code, reloading the configuration also reverts other changes made in the meantime."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new(restoreViaScript),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{Label: "Set original cap via Script Console", Value: restoreViaScript},
+					action_kit_api.ExplicitParameterOption{Label: "Reload Configuration as Code", Value: restoreViaCasc},
+				}),
+				Required: new(true),
+				Advanced: new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+	if a.targetType == TargetTypePodTemplate {
+		description.Id = podTemplateCapActionId
+		description.Label = "Limit Pod Template Cap"
+		description.Description = "Lowers the instance cap of a Kubernetes pod template, so no or fewer agents are provisioned from it. The original cap is restored when the step ends."
+		description.Icon = new(TargetIconPodTemplate)
+		description.TargetSelection = new(action_kit_api.TargetSelection{
+			TargetType: TargetTypePodTemplate,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "pod template name",
+					Query: "jenkins.pod-template.name=\"\"",
+				},
+			}),
+		})
+	} else {
+		description.Id = cloudCapActionId
+		description.Label = "Limit Cloud Cap"
+		description.Description = "Lowers the container cap of a Kubernetes cloud or the instance cap of other clouds, so no or fewer agents are provisioned. The original cap is restored when the step ends."
+		description.Icon = new(TargetIconCloud)
+		description.TargetSelection = new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeCloud,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "cloud name",
+					Query: "jenkins.cloud.name=\"\"",
+				},
+			}),
+		})
+	}
+	return description
+}
+
+func (a *cloudCapAction) Prepare(ctx context.Context, state *CloudCapActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startActionSpan(ctx, "jenkins.cloud.limit-cap.prepare", newBuildCorrelation(request.ExecutionContext), map[string]any{"jenkins.cloud.name": request.Target.Name})
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	state.CloudName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.cloud.name")[0]
+	if a.targetType == TargetTypePodTemplate {
+		state.TemplateName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.pod-template.name")[0]
+	}
+	state.Cap = extutil.ToInt(request.Config["cap"])
+	if state.Cap < 0 {
+		return nil, extension_kit.ToError("The cap must not be negative.", nil)
+	}
+	state.Restore = extutil.ToString(request.Config["restore"])
+	if state.Restore == "" {
+		state.Restore = restoreViaScript
+	}
+	// Pod templates are provided by the Kubernetes plugin, clouds may be provided by any plugin.
+	var requiredPlugins []string
+	if a.targetType == TargetTypePodTemplate {
+		requiredPlugins = append(requiredPlugins, "kubernetes")
+	}
+	if state.Restore == restoreViaCasc {
+		requiredPlugins = append(requiredPlugins, "configuration-as-code")
+	}
+	if err = checkPluginsInstalled(ctx, a.jenkins, requiredPlugins...); err != nil {
+		return nil, err
+	}
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *cloudCapAction) Start(ctx context.Context, state *CloudCapActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.cloud.limit-cap.start", state.Correlation, map[string]any{"jenkins.cloud.name": state.CloudName, "jenkins.pod-template.name": state.TemplateName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	if err = claimChange(cloudCapActionId, a.subject(state)); err != nil {
+		return nil, err
+	}
+	log.Info().Str("cloud", state.CloudName).Str("podTemplate", state.TemplateName).Int("cap", state.Cap).Msg("Limiting cap.")
+	original, err := a.setCap(ctx, state, new(state.Cap))
+	if err != nil {
+		releaseChange(cloudCapActionId, a.subject(state))
+		return nil, toJenkinsError(fmt.Sprintf("Failed to limit the cap of %s.", a.subject(state)), err)
+	}
+	state.OriginalCap = original
+	state.Applied = true
+
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Cap of %s lowered from %s to %d.", a.subject(state), formatCap(original), state.Cap),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *cloudCapAction) Stop(ctx context.Context, state *CloudCapActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.cloud.limit-cap.stop", state.Correlation, map[string]any{"jenkins.cloud.name": state.CloudName, "jenkins.pod-template.name": state.TemplateName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	if !state.Applied {
+		return nil, nil
+	}
+
+	var message string
+	if state.Restore == restoreViaCasc {
+		log.Info().Str("cloud", state.CloudName).Str("podTemplate", state.TemplateName).Msg("Reloading Configuration as Code to restore the cap.")
+		if _, err = postForm(ctx, a.jenkins, "/configuration-as-code/reload", url.Values{}, nil); err != nil {
+			return nil, toJenkinsError("Failed to reload Configuration as Code.", err)
+		}
+		message = fmt.Sprintf("- Configuration as Code reloaded, cap of %s restored.", a.subject(state))
+	} else {
+		log.Info().Str("cloud", state.CloudName).Str("podTemplate", state.TemplateName).Str("cap", formatCap(state.OriginalCap)).Msg("Restoring cap.")
+		if _, err = a.setCap(ctx, state, state.OriginalCap); err != nil {
+			return nil, toJenkinsError(fmt.Sprintf("Failed to restore the cap of %s.", a.subject(state)), err)
+		}
+		message = fmt.Sprintf("- Cap of %s restored to %s.", a.subject(state), formatCap(state.OriginalCap))
+	}
+	state.Applied = false
+	releaseChange(cloudCapActionId, a.subject(state))
+
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: message,
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+// setCap sets the cap of the cloud or pod template and returns the previous one. A nil cap means unlimited.
+func (a *cloudCapAction) setCap(ctx context.Context, state *CloudCapActionState, limit *int) (*int, error) {
+	templateName := "null"
+	if state.TemplateName != "" {
+		templateName = groovyString(state.TemplateName)
+	}
+	value := "null"
+	if limit != nil {
+		value = strconv.Itoa(*limit)
+	}
+	return runScript[*int](ctx, a.jenkins, fmt.Sprintf(setCapScript, groovyString(state.CloudName), templateName, value))
+}
+
+func (a *cloudCapAction) subject(state *CloudCapActionState) string {
+	if state.TemplateName != "" {
+		return fmt.Sprintf("pod template '%s' of cloud '%s'", state.TemplateName, state.CloudName)
+	}
+	return fmt.Sprintf("cloud '%s'", state.CloudName)
+}
+
+func formatCap(limit *int) string {
+	if limit == nil {
+		return "unlimited"
+	}
+	return capAttribute(limit)[0]
+}
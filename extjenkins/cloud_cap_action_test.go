@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudCapRejectsOverlappingSteps(t *testing.T) {
+	scripts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/scriptText" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		scripts++
+		_, _ = w.Write([]byte(`{"result":5}`))
+	}))
+	defer server.Close()
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+	jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+	action := &cloudCapAction{jenkins: jenkins, targetType: TargetTypePodTemplate}
+
+	first := &CloudCapActionState{CloudName: "kubernetes", TemplateName: "maven", Restore: restoreViaScript}
+	second := &CloudCapActionState{CloudName: "kubernetes", TemplateName: "maven", Restore: restoreViaScript}
+	other := &CloudCapActionState{CloudName: "kubernetes", TemplateName: "gradle", Restore: restoreViaScript}
+
+	_, err := action.Start(t.Context(), first)
+	require.NoError(t, err)
+	assert.Equal(t, new(5), first.OriginalCap)
+
+	_, err = action.Start(t.Context(), second)
+	assert.ErrorContains(t, err, "Another step is already changing pod template 'maven' of cloud 'kubernetes'.")
+	assert.False(t, second.Applied)
+	_, err = action.Stop(t.Context(), second)
+	require.NoError(t, err)
+
+	_, err = action.Start(t.Context(), other)
+	require.NoError(t, err, "other pod templates can be changed")
+	assert.Equal(t, 2, scripts)
+
+	_, err = action.Stop(t.Context(), first)
+	require.NoError(t, err)
+	_, err = action.Start(t.Context(), second)
+	require.NoError(t, err, "the cap can be changed again once restored")
+
+	_, _ = action.Stop(t.Context(), second)
+	_, _ = action.Stop(t.Context(), other)
+	assert.Empty(t, activeChanges.keys)
+}
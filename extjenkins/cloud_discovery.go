@@ -0,0 +1,180 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+type cloudDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*cloudDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*cloudDiscovery)(nil)
+)
+
+func NewCloudDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &cloudDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 1*time.Minute),
+	)
+}
+
+func (d *cloudDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeCloud,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *cloudDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeCloud,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconCloud),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Cloud", Other: "Jenkins Clouds"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.cloud.name"},
+				{Attribute: "jenkins.cloud.type"},
+				{Attribute: "jenkins.cloud.agent.count"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.cloud.name",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *cloudDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.cloud.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud name",
+				Other: "Cloud names",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.type",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud type",
+				Other: "Cloud types",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.cap",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud instance cap",
+				Other: "Cloud instance caps",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.namespace",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud namespace",
+				Other: "Cloud namespaces",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.agent",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud agent",
+				Other: "Cloud agents",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.agent.count",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud agent count",
+				Other: "Cloud agent counts",
+			},
+		},
+		{
+			Attribute: "jenkins.cloud.pod-template",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Cloud pod template",
+				Other: "Cloud pod templates",
+			},
+		},
+	}
+}
+
+// cloudAttributes lists all attributes of cloud targets, which may be excluded through DiscoveryAttributesExcludesCloud.
+var cloudAttributes = []string{
+	"jenkins.cloud.name",
+	"jenkins.cloud.type",
+	"jenkins.cloud.cap",
+	"jenkins.cloud.namespace",
+	"jenkins.cloud.agent",
+	"jenkins.cloud.agent.count",
+	"jenkins.cloud.pod-template",
+}
+
+func (d *cloudDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.clouds", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	clouds, err := getClouds(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeCloud, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch clouds.", err)
+	}
+
+	targets := make([]discovery_kit_api.Target, len(clouds))
+	for i, cloud := range clouds {
+		targets[i] = discovery_kit_api.Target{
+			Id:         cloud.Name,
+			TargetType: TargetTypeCloud,
+			Label:      cloud.Name,
+			Attributes: map[string][]string{
+				"jenkins.cloud.name":        {cloud.Name},
+				"jenkins.cloud.type":        {cloud.Type},
+				"jenkins.cloud.agent.count": {strconv.Itoa(len(cloud.Agents))},
+			},
+		}
+		if instanceCap := capAttribute(cloud.Cap); instanceCap != nil {
+			targets[i].Attributes["jenkins.cloud.cap"] = instanceCap
+		}
+		if cloud.Namespace != "" {
+			targets[i].Attributes["jenkins.cloud.namespace"] = []string{cloud.Namespace}
+		}
+		if len(cloud.Agents) > 0 {
+			targets[i].Attributes["jenkins.cloud.agent"] = agentNames(cloud.Agents)
+		}
+		if len(cloud.Templates) > 0 {
+			templates := make([]string, len(cloud.Templates))
+			for j, template := range cloud.Templates {
+				templates[j] = template.Name
+			}
+			targets[i].Attributes["jenkins.cloud.pod-template"] = templates
+		}
+	}
+	observeDiscovery(TargetTypeCloud, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesCloud), nil
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/extension-jenkins/config"
+)
+
+// cloudsScript lists the configured clouds, the Kubernetes pod templates and the names of the agents provisioned by
+// them. Clouds do not expose their configuration via the REST API, so it is read through the Script Console. The nodes
+// themselves are read via the REST API, the script only links them to their cloud and pod template.
+const cloudsScript = `
+    def instance = jenkins.model.Jenkins.get()
+    def has = { object, property -> object.metaClass.hasProperty(object, property) != null }
+    def agentOf = { node -> [name: node.nodeName, namespace: has(node, 'namespace') ? node.namespace : null] }
+    return instance.clouds.collect { cloud ->
+      def namespace = has(cloud, 'namespace') ? cloud.namespace : null
+      def agents = instance.nodes.findAll { node -> has(node, 'cloudName') && node.cloudName == cloud.name }
+      def templates = has(cloud, 'templates') ? cloud.templates : []
+      [
+        name: cloud.name,
+        type: cloud.descriptor.displayName,
+        cap: has(cloud, 'containerCap') ? cloud.containerCap : (has(cloud, 'instanceCap') ? cloud.instanceCap : null),
+        namespace: namespace,
+        agents: agents.collect(agentOf),
+        templates: templates.findAll { template -> template.class.name == 'org.csanchez.jenkins.plugins.kubernetes.PodTemplate' }.collect { template -> [
+          id: template.id,
+          name: template.name,
+          labels: template.label ?: '',
+          cap: template.instanceCap,
+          namespace: template.namespace ?: namespace,
+          agents: agents.findAll { node -> has(node, 'templateId') && node.templateId == template.id }.collect(agentOf),
+        ] },
+      ]
+    }`
+
+// cloud is a cloud configured in Jenkins, which provisions agents on demand.
+type cloud struct {
+	Name string `json:"name"`
+	// Type is the display name of the cloud implementation, like 'Kubernetes'.
+	Type string `json:"type"`
+	// Cap limits the number of agents provisioned by the cloud. It is the container cap of Kubernetes clouds.
+	Cap       *int            `json:"cap"`
+	Namespace string          `json:"namespace"`
+	Agents    []cloudAgent    `json:"agents"`
+	Templates []cloudTemplate `json:"templates"`
+}
+
+// cloudTemplate is a Kubernetes pod template of a cloud.
+type cloudTemplate struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// Labels are separated by whitespace.
+	Labels string `json:"labels"`
+	// Cap is the instance cap of the template.
+	Cap       *int         `json:"cap"`
+	Namespace string       `json:"namespace"`
+	Agents    []cloudAgent `json:"agents"`
+}
+
+// cloudAgent is an agent provisioned by a cloud. The agents of Kubernetes clouds are named like their pods.
+type cloudAgent struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+const (
+	// cloudsTtl lets the discoveries of nodes, clouds and pod templates, which run every minute, share one script run.
+	cloudsTtl = 30 * time.Second
+	// cloudsDeniedTtl is the time to wait before trying to read the clouds again without the required permission.
+	cloudsDeniedTtl = 10 * time.Minute
+)
+
+// cloudsCache holds the clouds last read from Jenkins.
+var cloudsCache struct {
+	mu        sync.Mutex
+	jenkins   *gojenkins.Jenkins
+	clouds    []cloud
+	expiresAt time.Time
+}
+
+var warnCloudsDenied sync.Once
+
+// getClouds lists the clouds configured in Jenkins. The clouds are read once for all discoveries needing them and
+// cached for a short time. Unless enabled through the configuration or without the Overall/Administer permission
+// required to read them, no clouds are returned.
+func getClouds(ctx context.Context, jenkins *gojenkins.Jenkins) ([]cloud, error) {
+	if !config.Config.CloudsEnabled {
+		return nil, nil
+	}
+	cloudsCache.mu.Lock()
+	defer cloudsCache.mu.Unlock()
+	if cloudsCache.jenkins == jenkins && time.Now().Before(cloudsCache.expiresAt) {
+		return cloudsCache.clouds, nil
+	}
+
+	clouds, err := runScript[[]cloud](withReadOnly(ctx), jenkins, cloudsScript)
+	ttl := cloudsTtl
+	if isScriptConsoleDenied(err) {
+		warnCloudsDenied.Do(func() {
+			log.Warn().Msg("Clouds and pod templates are not discovered, reading them requires the Overall/Administer permission.")
+		})
+		clouds, err, ttl = nil, nil, cloudsDeniedTtl
+	}
+	if err != nil {
+		return nil, err
+	}
+	cloudsCache.jenkins, cloudsCache.clouds, cloudsCache.expiresAt = jenkins, clouds, time.Now().Add(ttl)
+	return clouds, nil
+}
+
+// capAttribute formats the cap of a cloud or template, which is the maximum integer if unlimited.
+func capAttribute(limit *int) []string {
+	if limit == nil {
+		return nil
+	}
+	if *limit >= math.MaxInt32 {
+		return []string{"unlimited"}
+	}
+	return []string{strconv.Itoa(*limit)}
+}
+
+func agentNames(agents []cloudAgent) []string {
+	names := make([]string, len(agents))
+	for i, agent := range agents {
+		names[i] = agent.Name
+	}
+	return names
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cloudsResult = `{"result":[{"name":"kubernetes","type":"Kubernetes","cap":10,"namespace":"ci",
+  "agents":[{"name":"agent-abc12","namespace":"ci"}],
+  "templates":[{"id":"t1","name":"maven","labels":"maven java","cap":5,"namespace":"ci","agents":[{"name":"agent-abc12","namespace":"ci"}]}]}]}`
+
+// startCloudsServer serves the nodes via REST and the clouds via the Script Console, answering scripts with the
+// given status, and enables the discovery of clouds. It returns the number of scripts run.
+func startCloudsServer(t *testing.T, scriptStatus *atomic.Int32) (*gojenkins.Jenkins, *atomic.Int32) {
+	var scripts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/computer/api/json":
+			_, _ = w.Write([]byte(`{"computer":[
+			  {"_class":"hudson.model.Hudson$MasterComputer","displayName":"Built-In Node","numExecutors":2},
+			  {"_class":"org.csanchez.jenkins.plugins.kubernetes.KubernetesComputer","displayName":"agent-abc12","numExecutors":1,"assignedLabels":[{"name":"agent-abc12"},{"name":"maven"}]}]}`))
+		case "/scriptText":
+			scripts.Add(1)
+			w.WriteHeader(int(scriptStatus.Load()))
+			_, _ = w.Write([]byte(cloudsResult))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { cloudsCache.expiresAt = time.Time{} })
+	config.Config.CloudsEnabled = true
+	t.Cleanup(func() { config.Config.CloudsEnabled = false })
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+	jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+	return jenkins, &scripts
+}
+
+func targetsById(targets []discovery_kit_api.Target) map[string]discovery_kit_api.Target {
+	byId := make(map[string]discovery_kit_api.Target, len(targets))
+	for _, target := range targets {
+		byId[target.Id] = target
+	}
+	return byId
+}
+
+func TestDiscoveriesShareClouds(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, scripts := startCloudsServer(t, &status)
+
+	nodes, err := (&nodeDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+	clouds, err := (&cloudDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+	templates, err := (&podTemplateDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), scripts.Load(), "the clouds are read once for all discoveries")
+	agent := targetsById(nodes)["agent-abc12"]
+	assert.Equal(t, []string{"kubernetes"}, agent.Attributes["jenkins.cloud.name"])
+	assert.Equal(t, []string{"maven"}, agent.Attributes["jenkins.pod-template.name"])
+	assert.Equal(t, []string{"ci"}, agent.Attributes["jenkins.node.k8s.namespace"])
+	assert.Equal(t, []string{"10"}, targetsById(clouds)["kubernetes"].Attributes["jenkins.cloud.cap"])
+	assert.Len(t, templates, 1)
+
+	cloudsCache.expiresAt = time.Now()
+	_, err = (&cloudDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), scripts.Load(), "expired clouds are read again")
+}
+
+func TestNodeDiscoveryWithoutClouds(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, scripts := startCloudsServer(t, &status)
+	config.Config.CloudsEnabled = false
+
+	nodes, err := (&nodeDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+
+	require.NoError(t, err)
+	assert.Len(t, nodes, 2)
+	assert.NotContains(t, targetsById(nodes)["agent-abc12"].Attributes, "jenkins.cloud.name")
+	assert.Zero(t, scripts.Load(), "the Script Console is not used unless enabled")
+}
+
+func TestDiscoveriesWithoutAdminister(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusForbidden)
+	jenkins, scripts := startCloudsServer(t, &status)
+
+	nodes, err := (&nodeDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+	clouds, err := (&cloudDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+
+	assert.Len(t, nodes, 2, "nodes are discovered without their clouds")
+	assert.NotContains(t, targetsById(nodes)["agent-abc12"].Attributes, "jenkins.cloud.name")
+	assert.Empty(t, clouds)
+	assert.Equal(t, int32(1), scripts.Load())
+	assert.True(t, cloudsCache.expiresAt.After(time.Now().Add(cloudsTtl)), "the denial is remembered longer")
+}
+
+func TestDiscoveriesRetryFailedClouds(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	jenkins, scripts := startCloudsServer(t, &status)
+
+	_, err := (&cloudDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	assert.Error(t, err)
+
+	status.Store(http.StatusOK)
+	clouds, err := (&cloudDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, clouds, 1)
+	assert.Equal(t, int32(2), scripts.Load(), "failures are not cached")
+}
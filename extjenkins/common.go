@@ -1,6 +1,21 @@
 package extjenkins
 
 const (
-	TargetTypeJob = "com.steadybit.extension_jenkins.job"
-	TargetIconJob = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj4KPHBhdGggZD0iTTMuNjE0ODMgMjNIMi43MTY4NUMyLjY5MTkzIDIyLjkzOTUgMi42NzA0NiAyMi44NzgzIDIuNjUyNDYgMjIuODIxM0MyLjQ1Mzc2IDIyLjIwODcgMi4xMDQxMiAyMS40NTI1IDIuMDE0ODEgMjAuODQ0MUMxLjg3NzAzIDE5Ljk0MjIgMi43MzIwOCAxOS44OTIgMy4yODM4OSAxOS41MDIyQzQuMTI3ODcgMTguODk2NiA0Ljc5MTE0IDE4LjU2MzkgNS43MDcxMiAxOC4wMTUzQzUuOTgxMyAxNy44NTUxIDYuNzk4OTYgMTcuNDQ2MSA2Ljg4OTY2IDE3LjI1NjNDNy4wNzMxNCAxNi44ODQ0IDYuNTcxODcgMTYuMzU3OCA2LjQzODk0IDE2LjA2NUM2LjIyOTE2IDE1LjYwMyA2LjExNzY5IDE1LjIwNSA2LjA4OTMgMTQuNzUwNkM1LjMyODQxIDE0LjYyODkgNC43NDgyMSAxNC4xNzM4IDQuMzg2ODEgMTMuNjY1MUMzLjgwNTkyIDEyLjgxNzQgMy40MDI5NyAxMS4yNDg3IDMuOTA0MjQgMTAuMDU2NkMzLjk0MzcgOS45NjMxMyA0LjEzODk0IDkuNzc2ODMgNC4xNjczMyA5LjYzMDQxQzQuMjIwNjQgOS4zNDcxOCA0LjA2OTcxIDguOTcxMTQgNC4wNTU4NiA4LjY3MDcyQzMuOTk3NyA3LjEyMDUxIDQuMzE4OTYgNS43ODU0OCA1LjM3MTMzIDUuMzE1OTVDNS43OTcxMyAzLjYyOTYzIDcuMzIzMDggMy4wNjcyOSA4Ljc2MTA5IDIuMjI5OTdDOS4yOTgzNiAxLjkxNDQzIDkuODkzNzggMS43MTM2OSAxMC41MDY1IDEuNDg4MjFDMTIuNjk1IDAuNjg1OTUgMTYuMDcwMyAwLjgzNjUwMiAxNy44ODc3IDIuMjA3OTdDMTguNjU5NyAyLjc4ODE4IDE5Ljg5NjIgNC4wMTI1NCAyMC4zMzkzIDQuOTAwNzNDMjEuNTA0NSA3LjI0Mjg4IDIxLjQxNzMgMTEuMTU5MyAyMC42MDI0IDE0LjAwODhDMjAuNDkwOSAxNC4zOTE3IDIwLjMzNTkgMTQuOTU0NyAyMC4xMTIyIDE1LjQxMzNDMTkuOTU3MSAxNS43MzE2IDE5LjQ3NDYgMTYuMzc1NyAxOS41MzU1IDE2LjY1ODlDMTkuNTkzNiAxNi45NDgzIDIwLjYzMjIgMTcuNzMzNCAyMC44NTU4IDE3LjkzNzZDMjEuMjU1MyAxOC4zMjMzIDIyLjAyMSAxOC44MzIgMjIuMDc3OCAxOS4zMTI1QzIyLjE0MjIgMTkuODI0IDIxLjg1MDcgMjAuNTM2MiAyMS42OTkxIDIxLjAzMTFDMjEuNTAwNCAyMS42OTMyIDIxLjI5ODIgMjIuMzUxNyAyMS4wOTc0IDIyLjk4NTZIMy42MTQ4M1YyM1pNMTMuODY3MiAxOS43NTU5QzEzLjM2NTkgMTkuNDgwMiAxMi42MTIgMTkuMTg2NyAxMS45NTkxIDE5LjA2MDlDMTEuMTU4NyAxOC45MTAzIDExLjI0MTggMjAuMTQ5OCAxMS4yNjY3IDIwLjg4NjhDMTEuMjk1MSAyMS40NzggMTEuNjAxOCAyMi4wOTM5IDExLjczOTYgMjIuNDg3MkMxMS44MDgxIDIyLjY2NjYgMTEuODIyNyAyMi44NjM5IDExLjk3NzggMjIuODk5NkMxMi4yNTE5IDIyLjk2MDEgMTMuMTY3OSAyMi41OTc4IDEzLjQzMSAyMi40NTlDMTMuOTgyOCAyMi4xNTg2IDE0LjQxMTQgMjEuNjg1NiAxNC44ODQzIDIxLjM3MDdDMTQuODk4OCAyMS4yMTMzIDE0Ljg5ODggMjEuMDU5MyAxNC45MTI2IDIwLjkwNTNDMTQuNjM1NyAyMC43Njg1IDE0LjMxNzkgMjAuNjY4OCAxMy44OTk3IDIwLjY1MTdDMTQuMTg3OCAyMC41MTE0IDE0LjU5MjEgMjAuNTExNCAxNC44NTUyIDIwLjM0NjRMMTQuODY5IDIwLjE2NzdDMTQuNDExNCAyMC4xNDI5IDE0LjIzNDggMTkuOTM0NiAxMy45MjgxIDE5Ljc3MUwxMy44NjcyIDE5Ljc1NTlaTTIwLjc1MTMgMjIuNDQ1MkMyMC45Mjc4IDIxLjg3ODggMjEuMDc4NyAyMS4zMjgxIDIxLjE3OTggMjAuODQxNEMyMS4yMzQ1IDIwLjU3NTQgMjEuMzc4NSAyMCAyMS4zNDI1IDE5Ljc2MzVDMjEuMjg5MiAxOS4zNDA3IDIwLjcxMTggMTkuMDI5MyAyMC40MTU1IDE4Ljc2OEMxOS44NzgyIDE4LjI4NDEgMTkuNTM5NiAxNy44NzIzIDE4Ljk3NzUgMTcuNDE3OUMxOC43NDY5IDE3Ljc2MTYgMTguMjU1MyAxNy45ODM3IDE4LjA2ODQgMTguMjU1OUMxOS40MDY3IDE3LjYyNjIgMTkuNjQ3NyAyMC42NjIgMTkuMTIxNSAyMS42NDAyQzE5LjIwNDUgMjEuOTQwNiAxOS40ODIyIDIyLjA1MiAxOS41OTc4IDIyLjMxMzJMMTkuNTE4MiAyMi40NjcySDIwLjcwODNDMjAuNzE4NyAyMi40NjcyIDIwLjczNjcgMjIuNDY3MiAyMC43NDc4IDIyLjQ3ODJMMjAuNzUxMyAyMi40NDUyWk0xNC42MjM5IDIyLjQzNDJDMTQuNTc2OSAyMi4zNjYyIDE0LjUyOTggMjIuMzA4NCAxNC40ODYyIDIyLjI0NDVMMTQuMjA5MiAyMi40MTk4SDE0LjYyMzlWMjIuNDM0MlpNMTcuMTgwMSAyMi40MzQyQzE3LjE4NzcgMjIuMjQ0NSAxNy4xOTg4IDIyLjA2NTEgMTcuMjA5OSAyMS44ODYzQzE2LjcxOSAyMS45MTExIDE2LjQ0ODMgMjEuNDQ2NCAxNi4xMDU2IDIxLjQwM0MxNS44MDY1IDIxLjM2MzkgMTUuNTUxIDIxLjczMjMgMTUuMTY0NyAyMS41ODE4QzE1LjA3NCAyMS42Nzg3IDE0Ljk5NSAyMS43OTAxIDE0LjkwMTYgMjEuODcyNkMxNS4wNDIxIDIyLjAzNjkgMTUuMTcyMyAyMi4yMTYzIDE1LjI5MTQgMjIuNDA2SDE2LjA0NDZDMTYuMDU5MiAyMi4yNTU1IDE2LjE3MTMgMjIuMTQ0MSAxNi4zMjIzIDIyLjE0NDFDMTYuNDczMiAyMi4xNDQxIDE2LjU4NTQgMjIuMjU2MiAxNi41ODU0IDIyLjM5NUgxNy4xOTQ2TDE3LjE4MDEgMjIuNDM0MlpNMTkuMTM1MyAyMi40MzQyQzE4Ljg0NjYgMjEuOTkzNiAxOC4yNjIzIDIxLjYxIDE3LjU4NDQgMjEuOTI1NUwxNy41NTYxIDIyLjQxOThIMTkuMTM1M1YyMi40MzQyWk0xMS4yODEzIDIyLjQzNDJMMTEuMTgzNiAyMi4xMTg3QzEwLjk3NTIgMjEuNDU2IDEwLjg1MiAyMC45NjE3IDEwLjgwOTEgMjAuNTc4OEM5Ljk2NTA5IDIwLjE3OCA5LjA3ODE5IDE5Ljc4MDcgOC4zNjA5MSAxOS4yNzI2QzguMjE5NjcgMTkuMTc1NyA3LjMzNjIzIDE4LjAzMzEgNy4yMjQ3NiAxOC4wNzY1QzUuNjE2NDMgMTguNjk1OSA0LjEyMzcxIDE5Ljc4MDcgMi43NzkxNiAyMC44MTE4QzMuMDE3MzMgMjEuMzIwNiAzLjIyMjI3IDIxLjg1NzUgMy40MTY4MiAyMi40MDZIMTEuMjY3NEwxMS4yODEzIDIyLjQzNDJaTTE4LjkwODIgMjAuNDk3N0MxOC44ODI2IDIwLjAyODEgMTguNzU1OSAxOS4wNjg1IDE4LjQ2NzkgMTguOTAzNUMxNy44NTg2IDE4LjU0NiAxNi43NjE5IDE5LjYxNjQgMTYuMzA0MyAxOS43NjY5QzE2LjM0NzkgMTkuOTAyMyAxNi40MzEgMjAuMDEzNyAxNi40NDU1IDIwLjIwNjlDMTYuNzA4NiAyMC4xMzg4IDE3LjA0MDIgMjAuMTgyMSAxNy4yNzQzIDIwLjI5MjhDMTYuOTk2NiAyMC4zMTc2IDE2LjY5MzQgMjAuMzE3NiAxNi41MTM0IDIwLjQ0MzRDMTYuNDQ0OCAyMC42MTg3IDE2LjUyNzIgMjAuODgwNiAxNi40ODM2IDIxLjE0MThDMTcuMTIyNiAyMS4zMjQgMTcuODY4MyAyMS40MjAyIDE4LjY4NzQgMjEuNDQ2NEMxOC44MzkgMjEuMjM4MSAxOC44OTcxIDIwLjg1NTEgMTguODgxOSAyMC40NTQ0TDE4LjkwODIgMjAuNDk3N1pNMTUuMTQzMiAyMC4xNjc3QzE1LjA5OTYgMjAuNTExNCAxNS4xODI3IDIwLjYzNzIgMTUuMjUxOSAyMS4wMzExQzE2LjQxNTcgMjEuMzg4NiAxNi4yMDczIDE5LjQzNjkgMTUuMTI5NCAyMC4xNTM5TDE1LjE0MzIgMjAuMTY3N1pNOS4wNTMyNyAxOC44NzUzQzguNjM3ODUgMTkuMjkzOSAxMC4yMjQ3IDE5Ljg2NzMgMTAuNzI2IDE5Ljg5NTVDMTAuNzI2IDE5LjYzMDggMTAuODc3NiAxOS4zODA2IDEwLjg1MjcgMTkuMTkwOEMxMC4yNTM4IDE5LjA4MzYgOS40NjQ1MiAxOS4xNTUxIDkuMDU3NDIgMTguODcxOEw5LjA1MzI3IDE4Ljg3NTNaTTE0LjE4NzggMTkuMDcyNkMxNC4xODc4IDE5LjExMTggMTQuMTM0NCAxOS4wOTczIDE0LjEyNjggMTkuMTI5NkMxNC42NjQxIDE5LjU0NDkgMTUuMDYzNiAxOS42MzA4IDE1Ljc5MTkgMTkuNTk5MkMxNi4xMTY3IDE5LjM1OTIgMTYuNDA4OCAxOS4wODQzIDE2Ljc1NSAxOC44NTc0QzE1Ljk2NTcgMTguOTI2MiAxNC45NzA4IDE5LjQxNjMgMTQuMTkxMiAxOS4wNjkxTDE0LjE4NzggMTkuMDcyNlpNMTcuMzQ2MyAyLjgyMzkzQzE1Ljg2NDYgMS45OTM0OSAxMy4zMjk5IDEuMzY2NTMgMTEuNzM4OSAyLjE1NTA0QzEwLjQ2MjIgMi43ODgxOCA4LjcxNjc4IDMuODQxMzYgOC4xMzY1OSA1LjE3MzY1QzguNjkxMTcgNi40NTUwNiA3Ljk4NDI3IDcuNjMyNjcgNy45MzA5NiA4LjkzNjA4QzcuOTEyMjcgOS42MzEwOSA4LjI2MjYgMTAuMjM5NSA4LjI5MTY4IDEwLjk5NUM4LjEwMzM2IDExLjMwMyA3LjUyNjYzIDExLjM0MjIgNy4xMjY0NSAxMS4zMjE1QzYuOTkyODIgMTAuNjUxMyA2Ljc1NDY1IDkuODk5ODkgNi4wNTk1MyA5LjgyNDk2QzUuMDc4NDcgOS43MjExNSA0LjM1NzA0IDEwLjUyNjggNC4zMTQxMSAxMS4zNjgzQzQuMjU5NDEgMTIuMzYwMyA1LjA4NjA4IDEzLjk5NjQgNi4yMzk1NCAxMy44ODUxQzYuNjkwMjcgMTMuODQxNyA2LjgwMTczIDEzLjM5MDggNy4yOTE5MiAxMy4zOTA4QzcuNTU1NzEgMTMuOTEzMiA2Ljg4MTM1IDE0LjA3ODIgNi44MDkzNSAxNC40NDY3QzYuNzk0ODEgMTQuNTQyOSA2Ljg2NDA1IDE0LjkxNjIgNi45MDY5NyAxNS4wOTVDNy4xMTk1MiAxNS45NTc3IDcuNTkxNzEgMTcuMDcxNCA4LjA1MzUxIDE3LjczNDFDOC42NDA2MiAxOC41NTcgOS43OTQ3OCAxOC42OTcyIDExLjAzNTUgMTguNzc5N0MxMS4yNTQ5IDE4LjI5OTIgMTIuMDc0IDE4LjMzOTEgMTIuNjExMyAxOC40NjQyQzExLjk3MzYgMTguMjEzMyAxMS4zNzgyIDE3LjU5NzMgMTAuODgwNCAxNy4wNjExQzEwLjMxMDYgMTYuNDQxNyA5Ljc0NDkzIDE1Ljc2OCA5LjcxNTE2IDE0Ljk2OTJDMTAuNzgzNSAxNi40NDEgMTEuNjUxNyAxNy43MTkgMTMuNTkyMyAxOC4zNjczQzE1LjA2MDEgMTguODQ3OCAxNi43NzY1IDE4LjEzNDIgMTcuODk4MSAxNy4zNTA1QzE4LjM2OTYgMTcuMDIxMiAxOC42NDc5IDE2LjQ5ODEgMTguOTgwMiAxNi4wMjk5QzIwLjIyMzcgMTQuMjU3IDIwLjgwOCAxMS43MTU1IDIwLjY4MTMgOS4yNTE2MkMyMC42MjggOC4yMzQ4OCAyMC42MjggNy4yMTc0NSAyMC4yODE4IDYuNTQ1MTJDMTkuOTIxMSA1LjgyOTQ4IDE4LjcxNjQgNS4xOTQ5NiAxNy45OTUgNS44Mjk0OEMxNy44NTg2IDUuMTI3NTkgMTguNTc1OSA0LjcwMTM3IDE5LjQyMzMgNC45NDg4NUMxOC44MTQxIDQuMTY0NDYgMTguMTkwMiAzLjI0Mzk2IDE3LjMzMTcgMi43NjQ4MUwxNy4zNDYzIDIuODIzOTNaTTEzLjUwMjMgMTQuNjU0M0MxNC4wNjggMTYuMDcxMiAxNi4wMTk3IDE1LjkwMzQgMTcuNjY0MSAxNS44Njc3QzE3LjU4NDQgMTYuMDQ2NCAxNy40MjU5IDE2LjI2NDMgMTcuMjMxMyAxNi4zNEMxNi43MDg2IDE2LjU1MTcgMTUuMjUxOSAxNi43MTMyIDE0LjUyMDEgMTYuMzI5NkMxNC4wNTQ4IDE2LjA3ODcgMTMuNzU4NSAxNS41Mjc0IDEzLjUwMyAxNS4yMDVDMTMuMzc2MyAxNS4wNDc1IDEyLjc3MTIgMTQuNjQ2OCAxMy40OTE5IDE0LjY0NjhMMTMuNTAyMyAxNC42NTQzWk0xMy42NTQgMTMuODU0OEMxNC40Nzk5IDE0LjI4MSAxNS45ODAzIDE0LjMzMTIgMTcuMDk4NCAxNC4yOTU1QzE3LjE1OTMgMTQuNTQyMyAxNy4xNTkzIDE0LjgzOTkgMTcuMTYyOCAxNS4xMzM1QzE1LjczMSAxNS4yMDg0IDE0LjAzNjEgMTQuODUzNyAxMy42NTc0IDEzLjg1NDhIMTMuNjU0Wk0xOS44MTY2IDEzLjMyMTNDMTkuMzc5NyAxNC4xNDU2IDE4Ljc1OTQgMTUuMDU4NSAxNy40NzMgMTUuMDg2N0MxNy40NTA4IDE0LjgyODkgMTcuNDMzNSAxNC40MTM3IDE3LjQ3MyAxNC4yNTk3QzE4LjQ1MzMgMTQuMTYyOCAxOS4wNjYxIDEzLjY2NTEgMTkuODIwNyAxMy4zMjQ4TDE5LjgxNjYgMTMuMzIxM1pNMTkuMjE3NyAxMi43MDk1QzE4LjI3NjggMTMuMzE1MiAxNy4yMjcyIDEzLjk2OTYgMTUuNjg3NCAxMy44MTk3QzE1LjM2MzQgMTMuNTMzOCAxNS4yNDA4IDEyLjg5OTIgMTUuNTU3OSAxMi40ODA2QzE1LjcyMzQgMTIuNzcxNCAxNS42MTEyIDEzLjI5MzggMTYuMDg0MSAxMy4zNjg4QzE2Ljk1NjUgMTMuNTIyOCAxNy45NjY2IDEyLjgzODggMTguNjA0MyAxMi41OTg4QzE4Ljk4OTkgMTEuOTQ3MSAxOC41NjA3IDExLjcwNzkgMTguMjE1OSAxMS4yODkyQzE3LjQ5MzcgMTAuNDMzNCAxNi41MjcyIDkuMzYyMyAxNi41NTM1IDguMDY5ODlDMTYuODQxNSA3Ljg2NTcxIDE2Ljg3NDEgOC4zODg4NyAxNi45MTM1IDguNDgxNjdDMTcuMjg5NSA5LjM2MjMgMTguMjI5NyAxMC40NzYgMTguOTIyMSAxMS4yMzE1QzE5LjA4ODIgMTEuNDI0NyAxOS4zNjU5IDExLjU4OTYgMTkuMzkwOCAxMS43MTU1QzE5LjQ3NzMgMTIuMDY5NSAxOS4xNTYxIDEyLjQ5NTcgMTkuMTk5NyAxMi43MzIyTDE5LjIxNzcgMTIuNzA5NVpNNi44MTAwNCAxMi4wOTA4QzYuNTIyMDIgMTEuOTIyNCA2LjQ0OTMzIDExLjE4NDEgNi4xMDMxNSAxMS4xNjY5QzUuNjA5NSAxMS4xMzg3IDUuNjk5NTEgMTIuMTI2NSA1LjY5OTUxIDEyLjcwMzNDNS4zNTY3OSAxMi40MDI5IDUuMjk4NjQgMTEuNDUyOCA1LjU0Nzg4IDEwLjk3MzdDNS4yNjI2MyAxMC44MzQxIDUuMTM3MzIgMTEuMTI0MiA0Ljk3ODA4IDExLjIzMTVDNS4xNzk1NSA5Ljc3NDA4IDcuMTM4MjIgMTAuNTYyNiA2LjgxMzUgMTIuMTEyMUw2LjgxMDA0IDEyLjA5MDhaTTUuMzE3MzMgNi40OTM1NkM0LjY4MjQ0IDcuMTg4NTcgNC44MTk1MyA4LjQ4Nzg2IDQuODkxNTMgOS40MTkzNkM2LjA0MTUzIDguNjk4OTEgNy41NjY3OCA5LjQ3MjI5IDcuNTUyOTQgMTAuNjk3M0M4LjEwNDA1IDEwLjY4MjkgNy43NTc4NyAxMC4wMTMzIDcuNjYwOTQgOS41ODM2NkM3LjMzMjA4IDguMTgwNTcgOC4yMDU4MyA2LjY2MjY3IDcuNjk2OTUgNS4zNzY0NEM2LjcxNTg4IDUuNDUxMzggNS45MDc5MSA1Ljg0OTQxIDUuMzE3MzMgNi40ODY2OFY2LjQ5MzU2Wk0xMy43MzcgNy41MTM3NEMxNC4wMTg4IDguMDMwMDEgMTQuMTA4MSA4LjU2NjkyIDE0LjUxMjUgOC45NTM5NUMxNC42ODkgOS4xMjkyNSAxNS4wMzg3IDkuMzQ0NDMgMTQuODY5IDkuODI3NzFDMTQuODI2OCA5LjkzODM5IDE0LjUzMDUgMTAuMTg1OSAxNC4zNjE1IDEwLjIzOTVDMTMuNzM3NyAxMC40MTgyIDEyLjI4MSAxMC4yNjc3IDEyLjc3MTIgOS40OTkxQzEzLjI5MTIgOS41MDk0MSAxMy45ODM1IDkuODI4MzkgMTQuMzY5MiA5LjQ1NTc5QzE0LjA4MDQgOC45NzUyNiAxMy41NTQzIDguMDUxMzMgMTMuNzQ4OCA3LjUwNDExTDEzLjczNyA3LjUxMzc0Wk0xOS40NTU5IDcuNTA0MTFIMTkuNTIxNkMxOS44MjQyIDguMTE2NjMgMjAuMDcyOCA4Ljc2NDIyIDIwLjQ0NzMgOS4zMDUyNEMyMC4xOTg4IDkuODgyMDEgMTguNTUxIDEwLjM5NjkgMTguNTggOS4zNTgxOEMxOC45NDA4IDkuMjAwNzUgMTkuNTUgOS4zMjU4NiAxOS44Njc4IDkuMTI5MjVDMTkuNjkwNiA4LjYxNzc5IDE5LjQyNCA4LjIwNTMyIDE5LjQ2NjkgNy41MDQxMUgxOS40NTU5Wk0xMy4wNjIgNi4wMjE5NkMxMS43NSA1LjcyMDE3IDExLjA5MzYgNi41NjU3NCAxMC43MDA0IDcuNDQ2MzdDMTAuMzQzMSA3LjM2MTEyIDEwLjQ4MzcgNi44ODA1OSAxMC41NzM3IDYuNjM3MjNDMTAuODA4NCA1Ljk5MzA5IDExLjc1NjIgNS4xNDEzNCAxMi41MjgyIDUuMjU2MTRDMTIuODYwNSA1LjMwOTA3IDEzLjMxNDcgNS42MDk0OSAxMy4wNjIgNi4wMjE5NlpNOS44NjgxNyAyLjQ2MjMzQzguNDI1MyAyLjg2MzExIDYuNTc4OCAzLjkwMTE3IDUuOTg3NTMgNS4xODY3MUM2LjQ0NjU2IDUuMTIyNzcgNi43NjI5NiA0Ljg5MzE2IDcuMjE3MTUgNC44NjQ5OEM3LjM5MDkzIDQuODUwNTQgNy42MTA0IDQuOTMzMDQgNy44MDkxMSA0Ljg3ODczQzguMjAyMzYgNC43ODI0OCA4LjUyOTg1IDMuOTAxODYgOC44MjYxNyAzLjU4NjMyQzkuMTE0MTkgMy4yNzIxNSA5LjQ1NjkxIDMuMTMxOTEgOS42OTUwOCAyLjg0NTkzQzkuODQ2NyAyLjc2MzQzIDEwLjA2OTYgMi43Nzc4NyAxMC4wODQ5IDIuNTQxMzlDMTAuMDE2MyAyLjQ3MjY0IDkuOTQ0MzIgMi40MTk3MSA5Ljg2ODg2IDIuNDQ0NDZMOS44NjgxNyAyLjQ2MjMzWiIgZmlsbD0iIzFEMjYzMiIvPgo8L3N2Zz4K"
+	TargetTypeJob              = "com.steadybit.extension_jenkins.job"
+	TargetTypeLockableResource = "com.steadybit.extension_jenkins.lockable-resource"
+	TargetTypeCloud            = "com.steadybit.extension_jenkins.cloud"
+	TargetTypePodTemplate      = "com.steadybit.extension_jenkins.pod-template"
+	TargetTypeNode             = "com.steadybit.extension_jenkins.node"
+	TargetTypeView             = "com.steadybit.extension_jenkins.view"
+	TargetTypeBuild            = "com.steadybit.extension_jenkins.build"
+	TargetTypeController       = "com.steadybit.extension_jenkins.controller"
+	TargetIconJob              = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj4KPHBhdGggZD0iTTMuNjE0ODMgMjNIMi43MTY4NUMyLjY5MTkzIDIyLjkzOTUgMi42NzA0NiAyMi44NzgzIDIuNjUyNDYgMjIuODIxM0MyLjQ1Mzc2IDIyLjIwODcgMi4xMDQxMiAyMS40NTI1IDIuMDE0ODEgMjAuODQ0MUMxLjg3NzAzIDE5Ljk0MjIgMi43MzIwOCAxOS44OTIgMy4yODM4OSAxOS41MDIyQzQuMTI3ODcgMTguODk2NiA0Ljc5MTE0IDE4LjU2MzkgNS43MDcxMiAxOC4wMTUzQzUuOTgxMyAxNy44NTUxIDYuNzk4OTYgMTcuNDQ2MSA2Ljg4OTY2IDE3LjI1NjNDNy4wNzMxNCAxNi44ODQ0IDYuNTcxODcgMTYuMzU3OCA2LjQzODk0IDE2LjA2NUM2LjIyOTE2IDE1LjYwMyA2LjExNzY5IDE1LjIwNSA2LjA4OTMgMTQuNzUwNkM1LjMyODQxIDE0LjYyODkgNC43NDgyMSAxNC4xNzM4IDQuMzg2ODEgMTMuNjY1MUMzLjgwNTkyIDEyLjgxNzQgMy40MDI5NyAxMS4yNDg3IDMuOTA0MjQgMTAuMDU2NkMzLjk0MzcgOS45NjMxMyA0LjEzODk0IDkuNzc2ODMgNC4xNjczMyA5LjYzMDQxQzQuMjIwNjQgOS4zNDcxOCA0LjA2OTcxIDguOTcxMTQgNC4wNTU4NiA4LjY3MDcyQzMuOTk3NyA3LjEyMDUxIDQuMzE4OTYgNS43ODU0OCA1LjM3MTMzIDUuMzE1OTVDNS43OTcxMyAzLjYyOTYzIDcuMzIzMDggMy4wNjcyOSA4Ljc2MTA5IDIuMjI5OTdDOS4yOTgzNiAxLjkxNDQzIDkuODkzNzggMS43MTM2OSAxMC41MDY1IDEuNDg4MjFDMTIuNjk1IDAuNjg1OTUgMTYuMDcwMyAwLjgzNjUwMiAxNy44ODc3IDIuMjA3OTdDMTguNjU5NyAyLjc4ODE4IDE5Ljg5NjIgNC4wMTI1NCAyMC4zMzkzIDQuOTAwNzNDMjEuNTA0NSA3LjI0Mjg4IDIxLjQxNzMgMTEuMTU5MyAyMC42MDI0IDE0LjAwODhDMjAuNDkwOSAxNC4zOTE3IDIwLjMzNTkgMTQuOTU0NyAyMC4xMTIyIDE1LjQxMzNDMTkuOTU3MSAxNS43MzE2IDE5LjQ3NDYgMTYuMzc1NyAxOS41MzU1IDE2LjY1ODlDMTkuNTkzNiAxNi45NDgzIDIwLjYzMjIgMTcuNzMzNCAyMC44NTU4IDE3LjkzNzZDMjEuMjU1MyAxOC4zMjMzIDIyLjAyMSAxOC44MzIgMjIuMDc3OCAxOS4zMTI1QzIyLjE0MjIgMTkuODI0IDIxLjg1MDcgMjAuNTM2MiAyMS42OTkxIDIxLjAzMTFDMjEuNTAwNCAyMS42OTMyIDIxLjI5ODIgMjIuMzUxNyAyMS4wOTc0IDIyLjk4NTZIMy42MTQ4M1YyM1pNMTMuODY3MiAxOS43NTU5QzEzLjM2NTkgMTkuNDgwMiAxMi42MTIgMTkuMTg2NyAxMS45NTkxIDE5LjA2MDlDMTEuMTU4NyAxOC45MTAzIDExLjI0MTggMjAuMTQ5OCAxMS4yNjY3IDIwLjg4NjhDMTEuMjk1MSAyMS40NzggMTEuNjAxOCAyMi4wOTM5IDExLjczOTYgMjIuNDg3MkMxMS44MDgxIDIyLjY2NjYgMTEuODIyNyAyMi44NjM5IDExLjk3NzggMjIuODk5NkMxMi4yNTE5IDIyLjk2MDEgMTMuMTY3OSAyMi41OTc4IDEzLjQzMSAyMi40NTlDMTMuOTgyOCAyMi4xNTg2IDE0LjQxMTQgMjEuNjg1NiAxNC44ODQzIDIxLjM3MDdDMTQuODk4OCAyMS4yMTMzIDE0Ljg5ODggMjEuMDU5MyAxNC45MTI2IDIwLjkwNTNDMTQuNjM1NyAyMC43Njg1IDE0LjMxNzkgMjAuNjY4OCAxMy44OTk3IDIwLjY1MTdDMTQuMTg3OCAyMC41MTE0IDE0LjU5MjEgMjAuNTExNCAxNC44NTUyIDIwLjM0NjRMMTQuODY5IDIwLjE2NzdDMTQuNDExNCAyMC4xNDI5IDE0LjIzNDggMTkuOTM0NiAxMy45MjgxIDE5Ljc3MUwxMy44NjcyIDE5Ljc1NTlaTTIwLjc1MTMgMjIuNDQ1MkMyMC45Mjc4IDIxLjg3ODggMjEuMDc4NyAyMS4zMjgxIDIxLjE3OTggMjAuODQxNEMyMS4yMzQ1IDIwLjU3NTQgMjEuMzc4NSAyMCAyMS4zNDI1IDE5Ljc2MzVDMjEuMjg5MiAxOS4zNDA3IDIwLjcxMTggMTkuMDI5MyAyMC40MTU1IDE4Ljc2OEMxOS44NzgyIDE4LjI4NDEgMTkuNTM5NiAxNy44NzIzIDE4Ljk3NzUgMTcuNDE3OUMxOC43NDY5IDE3Ljc2MTYgMTguMjU1MyAxNy45ODM3IDE4LjA2ODQgMTguMjU1OUMxOS40MDY3IDE3LjYyNjIgMTkuNjQ3NyAyMC42NjIgMTkuMTIxNSAyMS42NDAyQzE5LjIwNDUgMjEuOTQwNiAxOS40ODIyIDIyLjA1MiAxOS41OTc4IDIyLjMxMzJMMTkuNTE4MiAyMi40NjcySDIwLjcwODNDMjAuNzE4NyAyMi40NjcyIDIwLjczNjcgMjIuNDY3MiAyMC43NDc4IDIyLjQ3ODJMMjAuNzUxMyAyMi40NDUyWk0xNC42MjM5IDIyLjQzNDJDMTQuNTc2OSAyMi4zNjYyIDE0LjUyOTggMjIuMzA4NCAxNC40ODYyIDIyLjI0NDVMMTQuMjA5MiAyMi40MTk4SDE0LjYyMzlWMjIuNDM0MlpNMTcuMTgwMSAyMi40MzQyQzE3LjE4NzcgMjIuMjQ0NSAxNy4xOTg4IDIyLjA2NTEgMTcuMjA5OSAyMS44ODYzQzE2LjcxOSAyMS45MTExIDE2LjQ0ODMgMjEuNDQ2NCAxNi4xMDU2IDIxLjQwM0MxNS44MDY1IDIxLjM2MzkgMTUuNTUxIDIxLjczMjMgMTUuMTY0NyAyMS41ODE4QzE1LjA3NCAyMS42Nzg3IDE0Ljk5NSAyMS43OTAxIDE0LjkwMTYgMjEuODcyNkMxNS4wNDIxIDIyLjAzNjkgMTUuMTcyMyAyMi4yMTYzIDE1LjI5MTQgMjIuNDA2SDE2LjA0NDZDMTYuMDU5MiAyMi4yNTU1IDE2LjE3MTMgMjIuMTQ0MSAxNi4zMjIzIDIyLjE0NDFDMTYuNDczMiAyMi4xNDQxIDE2LjU4NTQgMjIuMjU2MiAxNi41ODU0IDIyLjM5NUgxNy4xOTQ2TDE3LjE4MDEgMjIuNDM0MlpNMTkuMTM1MyAyMi40MzQyQzE4Ljg0NjYgMjEuOTkzNiAxOC4yNjIzIDIxLjYxIDE3LjU4NDQgMjEuOTI1NUwxNy41NTYxIDIyLjQxOThIMTkuMTM1M1YyMi40MzQyWk0xMS4yODEzIDIyLjQzNDJMMTEuMTgzNiAyMi4xMTg3QzEwLjk3NTIgMjEuNDU2IDEwLjg1MiAyMC45NjE3IDEwLjgwOTEgMjAuNTc4OEM5Ljk2NTA5IDIwLjE3OCA5LjA3ODE5IDE5Ljc4MDcgOC4zNjA5MSAxOS4yNzI2QzguMjE5NjcgMTkuMTc1NyA3LjMzNjIzIDE4LjAzMzEgNy4yMjQ3NiAxOC4wNzY1QzUuNjE2NDMgMTguNjk1OSA0LjEyMzcxIDE5Ljc4MDcgMi43NzkxNiAyMC44MTE4QzMuMDE3MzMgMjEuMzIwNiAzLjIyMjI3IDIxLjg1NzUgMy40MTY4MiAyMi40MDZIMTEuMjY3NEwxMS4yODEzIDIyLjQzNDJaTTE4LjkwODIgMjAuNDk3N0MxOC44ODI2IDIwLjAyODEgMTguNzU1OSAxOS4wNjg1IDE4LjQ2NzkgMTguOTAzNUMxNy44NTg2IDE4LjU0NiAxNi43NjE5IDE5LjYxNjQgMTYuMzA0MyAxOS43NjY5QzE2LjM0NzkgMTkuOTAyMyAxNi40MzEgMjAuMDEzNyAxNi40NDU1IDIwLjIwNjlDMTYuNzA4NiAyMC4xMzg4IDE3LjA0MDIgMjAuMTgyMSAxNy4yNzQzIDIwLjI5MjhDMTYuOTk2NiAyMC4zMTc2IDE2LjY5MzQgMjAuMzE3NiAxNi41MTM0IDIwLjQ0MzRDMTYuNDQ0OCAyMC42MTg3IDE2LjUyNzIgMjAuODgwNiAxNi40ODM2IDIxLjE0MThDMTcuMTIyNiAyMS4zMjQgMTcuODY4MyAyMS40MjAyIDE4LjY4NzQgMjEuNDQ2NEMxOC44MzkgMjEuMjM4MSAxOC44OTcxIDIwLjg1NTEgMTguODgxOSAyMC40NTQ0TDE4LjkwODIgMjAuNDk3N1pNMTUuMTQzMiAyMC4xNjc3QzE1LjA5OTYgMjAuNTExNCAxNS4xODI3IDIwLjYzNzIgMTUuMjUxOSAyMS4wMzExQzE2LjQxNTcgMjEuMzg4NiAxNi4yMDczIDE5LjQzNjkgMTUuMTI5NCAyMC4xNTM5TDE1LjE0MzIgMjAuMTY3N1pNOS4wNTMyNyAxOC44NzUzQzguNjM3ODUgMTkuMjkzOSAxMC4yMjQ3IDE5Ljg2NzMgMTAuNzI2IDE5Ljg5NTVDMTAuNzI2IDE5LjYzMDggMTAuODc3NiAxOS4zODA2IDEwLjg1MjcgMTkuMTkwOEMxMC4yNTM4IDE5LjA4MzYgOS40NjQ1MiAxOS4xNTUxIDkuMDU3NDIgMTguODcxOEw5LjA1MzI3IDE4Ljg3NTNaTTE0LjE4NzggMTkuMDcyNkMxNC4xODc4IDE5LjExMTggMTQuMTM0NCAxOS4wOTczIDE0LjEyNjggMTkuMTI5NkMxNC42NjQxIDE5LjU0NDkgMTUuMDYzNiAxOS42MzA4IDE1Ljc5MTkgMTkuNTk5MkMxNi4xMTY3IDE5LjM1OTIgMTYuNDA4OCAxOS4wODQzIDE2Ljc1NSAxOC44NTc0QzE1Ljk2NTcgMTguOTI2MiAxNC45NzA4IDE5LjQxNjMgMTQuMTkxMiAxOS4wNjkxTDE0LjE4NzggMTkuMDcyNlpNMTcuMzQ2MyAyLjgyMzkzQzE1Ljg2NDYgMS45OTM0OSAxMy4zMjk5IDEuMzY2NTMgMTEuNzM4OSAyLjE1NTA0QzEwLjQ2MjIgMi43ODgxOCA4LjcxNjc4IDMuODQxMzYgOC4xMzY1OSA1LjE3MzY1QzguNjkxMTcgNi40NTUwNiA3Ljk4NDI3IDcuNjMyNjcgNy45MzA5NiA4LjkzNjA4QzcuOTEyMjcgOS42MzEwOSA4LjI2MjYgMTAuMjM5NSA4LjI5MTY4IDEwLjk5NUM4LjEwMzM2IDExLjMwMyA3LjUyNjYzIDExLjM0MjIgNy4xMjY0NSAxMS4zMjE1QzYuOTkyODIgMTAuNjUxMyA2Ljc1NDY1IDkuODk5ODkgNi4wNTk1MyA5LjgyNDk2QzUuMDc4NDcgOS43MjExNSA0LjM1NzA0IDEwLjUyNjggNC4zMTQxMSAxMS4zNjgzQzQuMjU5NDEgMTIuMzYwMyA1LjA4NjA4IDEzLjk5NjQgNi4yMzk1NCAxMy44ODUxQzYuNjkwMjcgMTMuODQxNyA2LjgwMTczIDEzLjM5MDggNy4yOTE5MiAxMy4zOTA4QzcuNTU1NzEgMTMuOTEzMiA2Ljg4MTM1IDE0LjA3ODIgNi44MDkzNSAxNC40NDY3QzYuNzk0ODEgMTQuNTQyOSA2Ljg2NDA1IDE0LjkxNjIgNi45MDY5NyAxNS4wOTVDNy4xMTk1MiAxNS45NTc3IDcuNTkxNzEgMTcuMDcxNCA4LjA1MzUxIDE3LjczNDFDOC42NDA2MiAxOC41NTcgOS43OTQ3OCAxOC42OTcyIDExLjAzNTUgMTguNzc5N0MxMS4yNTQ5IDE4LjI5OTIgMTIuMDc0IDE4LjMzOTEgMTIuNjExMyAxOC40NjQyQzExLjk3MzYgMTguMjEzMyAxMS4zNzgyIDE3LjU5NzMgMTAuODgwNCAxNy4wNjExQzEwLjMxMDYgMTYuNDQxNyA5Ljc0NDkzIDE1Ljc2OCA5LjcxNTE2IDE0Ljk2OTJDMTAuNzgzNSAxNi40NDEgMTEuNjUxNyAxNy43MTkgMTMuNTkyMyAxOC4zNjczQzE1LjA2MDEgMTguODQ3OCAxNi43NzY1IDE4LjEzNDIgMTcuODk4MSAxNy4zNTA1QzE4LjM2OTYgMTcuMDIxMiAxOC42NDc5IDE2LjQ5ODEgMTguOTgwMiAxNi4wMjk5QzIwLjIyMzcgMTQuMjU3IDIwLjgwOCAxMS43MTU1IDIwLjY4MTMgOS4yNTE2MkMyMC42MjggOC4yMzQ4OCAyMC42MjggNy4yMTc0NSAyMC4yODE4IDYuNTQ1MTJDMTkuOTIxMSA1LjgyOTQ4IDE4LjcxNjQgNS4xOTQ5NiAxNy45OTUgNS44Mjk0OEMxNy44NTg2IDUuMTI3NTkgMTguNTc1OSA0LjcwMTM3IDE5LjQyMzMgNC45NDg4NUMxOC44MTQxIDQuMTY0NDYgMTguMTkwMiAzLjI0Mzk2IDE3LjMzMTcgMi43NjQ4MUwxNy4zNDYzIDIuODIzOTNaTTEzLjUwMjMgMTQuNjU0M0MxNC4wNjggMTYuMDcxMiAxNi4wMTk3IDE1LjkwMzQgMTcuNjY0MSAxNS44Njc3QzE3LjU4NDQgMTYuMDQ2NCAxNy40MjU5IDE2LjI2NDMgMTcuMjMxMyAxNi4zNEMxNi43MDg2IDE2LjU1MTcgMTUuMjUxOSAxNi43MTMyIDE0LjUyMDEgMTYuMzI5NkMxNC4wNTQ4IDE2LjA3ODcgMTMuNzU4NSAxNS41Mjc0IDEzLjUwMyAxNS4yMDVDMTMuMzc2MyAxNS4wNDc1IDEyLjc3MTIgMTQuNjQ2OCAxMy40OTE5IDE0LjY0NjhMMTMuNTAyMyAxNC42NTQzWk0xMy42NTQgMTMuODU0OEMxNC40Nzk5IDE0LjI4MSAxNS45ODAzIDE0LjMzMTIgMTcuMDk4NCAxNC4yOTU1QzE3LjE1OTMgMTQuNTQyMyAxNy4xNTkzIDE0LjgzOTkgMTcuMTYyOCAxNS4xMzM1QzE1LjczMSAxNS4yMDg0IDE0LjAzNjEgMTQuODUzNyAxMy42NTc0IDEzLjg1NDhIMTMuNjU0Wk0xOS44MTY2IDEzLjMyMTNDMTkuMzc5NyAxNC4xNDU2IDE4Ljc1OTQgMTUuMDU4NSAxNy40NzMgMTUuMDg2N0MxNy40NTA4IDE0LjgyODkgMTcuNDMzNSAxNC40MTM3IDE3LjQ3MyAxNC4yNTk3QzE4LjQ1MzMgMTQuMTYyOCAxOS4wNjYxIDEzLjY2NTEgMTkuODIwNyAxMy4zMjQ4TDE5LjgxNjYgMTMuMzIxM1pNMTkuMjE3NyAxMi43MDk1QzE4LjI3NjggMTMuMzE1MiAxNy4yMjcyIDEzLjk2OTYgMTUuNjg3NCAxMy44MTk3QzE1LjM2MzQgMTMuNTMzOCAxNS4yNDA4IDEyLjg5OTIgMTUuNTU3OSAxMi40ODA2QzE1LjcyMzQgMTIuNzcxNCAxNS42MTEyIDEzLjI5MzggMTYuMDg0MSAxMy4zNjg4QzE2Ljk1NjUgMTMuNTIyOCAxNy45NjY2IDEyLjgzODggMTguNjA0MyAxMi41OTg4QzE4Ljk4OTkgMTEuOTQ3MSAxOC41NjA3IDExLjcwNzkgMTguMjE1OSAxMS4yODkyQzE3LjQ5MzcgMTAuNDMzNCAxNi41MjcyIDkuMzYyMyAxNi41NTM1IDguMDY5ODlDMTYuODQxNSA3Ljg2NTcxIDE2Ljg3NDEgOC4zODg4NyAxNi45MTM1IDguNDgxNjdDMTcuMjg5NSA5LjM2MjMgMTguMjI5NyAxMC40NzYgMTguOTIyMSAxMS4yMzE1QzE5LjA4ODIgMTEuNDI0NyAxOS4zNjU5IDExLjU4OTYgMTkuMzkwOCAxMS43MTU1QzE5LjQ3NzMgMTIuMDY5NSAxOS4xNTYxIDEyLjQ5NTcgMTkuMTk5NyAxMi43MzIyTDE5LjIxNzcgMTIuNzA5NVpNNi44MTAwNCAxMi4wOTA4QzYuNTIyMDIgMTEuOTIyNCA2LjQ0OTMzIDExLjE4NDEgNi4xMDMxNSAxMS4xNjY5QzUuNjA5NSAxMS4xMzg3IDUuNjk5NTEgMTIuMTI2NSA1LjY5OTUxIDEyLjcwMzNDNS4zNTY3OSAxMi40MDI5IDUuMjk4NjQgMTEuNDUyOCA1LjU0Nzg4IDEwLjk3MzdDNS4yNjI2MyAxMC44MzQxIDUuMTM3MzIgMTEuMTI0MiA0Ljk3ODA4IDExLjIzMTVDNS4xNzk1NSA5Ljc3NDA4IDcuMTM4MjIgMTAuNTYyNiA2LjgxMzUgMTIuMTEyMUw2LjgxMDA0IDEyLjA5MDhaTTUuMzE3MzMgNi40OTM1NkM0LjY4MjQ0IDcuMTg4NTcgNC44MTk1MyA4LjQ4Nzg2IDQuODkxNTMgOS40MTkzNkM2LjA0MTUzIDguNjk4OTEgNy41NjY3OCA5LjQ3MjI5IDcuNTUyOTQgMTAuNjk3M0M4LjEwNDA1IDEwLjY4MjkgNy43NTc4NyAxMC4wMTMzIDcuNjYwOTQgOS41ODM2NkM3LjMzMjA4IDguMTgwNTcgOC4yMDU4MyA2LjY2MjY3IDcuNjk2OTUgNS4zNzY0NEM2LjcxNTg4IDUuNDUxMzggNS45MDc5MSA1Ljg0OTQxIDUuMzE3MzMgNi40ODY2OFY2LjQ5MzU2Wk0xMy43MzcgNy41MTM3NEMxNC4wMTg4IDguMDMwMDEgMTQuMTA4MSA4LjU2NjkyIDE0LjUxMjUgOC45NTM5NUMxNC42ODkgOS4xMjkyNSAxNS4wMzg3IDkuMzQ0NDMgMTQuODY5IDkuODI3NzFDMTQuODI2OCA5LjkzODM5IDE0LjUzMDUgMTAuMTg1OSAxNC4zNjE1IDEwLjIzOTVDMTMuNzM3NyAxMC40MTgyIDEyLjI4MSAxMC4yNjc3IDEyLjc3MTIgOS40OTkxQzEzLjI5MTIgOS41MDk0MSAxMy45ODM1IDkuODI4MzkgMTQuMzY5MiA5LjQ1NTc5QzE0LjA4MDQgOC45NzUyNiAxMy41NTQzIDguMDUxMzMgMTMuNzQ4OCA3LjUwNDExTDEzLjczNyA3LjUxMzc0Wk0xOS40NTU5IDcuNTA0MTFIMTkuNTIxNkMxOS44MjQyIDguMTE2NjMgMjAuMDcyOCA4Ljc2NDIyIDIwLjQ0NzMgOS4zMDUyNEMyMC4xOTg4IDkuODgyMDEgMTguNTUxIDEwLjM5NjkgMTguNTggOS4zNTgxOEMxOC45NDA4IDkuMjAwNzUgMTkuNTUgOS4zMjU4NiAxOS44Njc4IDkuMTI5MjVDMTkuNjkwNiA4LjYxNzc5IDE5LjQyNCA4LjIwNTMyIDE5LjQ2NjkgNy41MDQxMUgxOS40NTU5Wk0xMy4wNjIgNi4wMjE5NkMxMS43NSA1LjcyMDE3IDExLjA5MzYgNi41NjU3NCAxMC43MDA0IDcuNDQ2MzdDMTAuMzQzMSA3LjM2MTEyIDEwLjQ4MzcgNi44ODA1OSAxMC41NzM3IDYuNjM3MjNDMTAuODA4NCA1Ljk5MzA5IDExLjc1NjIgNS4xNDEzNCAxMi41MjgyIDUuMjU2MTRDMTIuODYwNSA1LjMwOTA3IDEzLjMxNDcgNS42MDk0OSAxMy4wNjIgNi4wMjE5NlpNOS44NjgxNyAyLjQ2MjMzQzguNDI1MyAyLjg2MzExIDYuNTc4OCAzLjkwMTE3IDUuOTg3NTMgNS4xODY3MUM2LjQ0NjU2IDUuMTIyNzcgNi43NjI5NiA0Ljg5MzE2IDcuMjE3MTUgNC44NjQ5OEM3LjM5MDkzIDQuODUwNTQgNy42MTA0IDQuOTMzMDQgNy44MDkxMSA0Ljg3ODczQzguMjAyMzYgNC43ODI0OCA4LjUyOTg1IDMuOTAxODYgOC44MjYxNyAzLjU4NjMyQzkuMTE0MTkgMy4yNzIxNSA5LjQ1NjkxIDMuMTMxOTEgOS42OTUwOCAyLjg0NTkzQzkuODQ2NyAyLjc2MzQzIDEwLjA2OTYgMi43Nzc4NyAxMC4wODQ5IDIuNTQxMzlDMTAuMDE2MyAyLjQ3MjY0IDkuOTQ0MzIgMi40MTk3MSA5Ljg2ODg2IDIuNDQ0NDZMOS44NjgxNyAyLjQ2MjMzWiIgZmlsbD0iIzFEMjYzMiIvPgo8L3N2Zz4K"
+	TargetIconLockableResource = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48cGF0aCBkPSJNNyAxMFY3YTUgNSAwIDAgMSAxMCAwdjMiIHN0cm9rZT0iIzFEMjYzMiIgc3Ryb2tlLXdpZHRoPSIyIiBzdHJva2UtbGluZWNhcD0icm91bmQiLz48cmVjdCB4PSI0IiB5PSIxMCIgd2lkdGg9IjE2IiBoZWlnaHQ9IjEyIiByeD0iMiIgZmlsbD0iIzFEMjYzMiIvPjwvc3ZnPg=="
+	TargetIconCloud            = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48cGF0aCBkPSJNNyAxOWE1IDUgMCAwIDEtLjYtOS45NkE2IDYgMCAwIDEgMTggOC41YTQuNSA0LjUgMCAwIDEtLjUgMTAuNUg3WiIgZmlsbD0iIzFEMjYzMiIvPjwvc3ZnPg=="
+	TargetIconPodTemplate      = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48cGF0aCBkPSJNMTIgMiAyMSA3djEwbC05IDUtOS01VjdsOS01WiIgc3Ryb2tlPSIjMUQyNjMyIiBzdHJva2Utd2lkdGg9IjIiIHN0cm9rZS1saW5lam9pbj0icm91bmQiLz48cGF0aCBkPSJNMyA3bDkgNSA5LTVNMTIgMTJ2MTAiIHN0cm9rZT0iIzFEMjYzMiIgc3Ryb2tlLXdpZHRoPSIyIiBzdHJva2UtbGluZWpvaW49InJvdW5kIi8+PC9zdmc+"
+	TargetIconNode             = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48cmVjdCB4PSIzIiB5PSIzIiB3aWR0aD0iMTgiIGhlaWdodD0iOCIgcng9IjIiIGZpbGw9IiMxRDI2MzIiLz48cmVjdCB4PSIzIiB5PSIxMyIgd2lkdGg9IjE4IiBoZWlnaHQ9IjgiIHJ4PSIyIiBmaWxsPSIjMUQyNjMyIi8+PGNpcmNsZSBjeD0iNyIgY3k9IjciIHI9IjEiIGZpbGw9IiNmZmYiLz48Y2lyY2xlIGN4PSI3IiBjeT0iMTciIHI9IjEiIGZpbGw9IiNmZmYiLz48L3N2Zz4="
+	TargetIconView             = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48cmVjdCB4PSIzIiB5PSI0IiB3aWR0aD0iMTgiIGhlaWdodD0iMTYiIHJ4PSIyIiBzdHJva2U9IiMxRDI2MzIiIHN0cm9rZS13aWR0aD0iMiIvPjxwYXRoIGQ9Ik0zIDloMThNOSA5djExIiBzdHJva2U9IiMxRDI2MzIiIHN0cm9rZS13aWR0aD0iMiIvPjwvc3ZnPg=="
+	TargetIconBuild            = "data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjQiIGhlaWdodD0iMjQiIHZpZXdCb3g9IjAgMCAyNCAyNCIgZmlsbD0ibm9uZSIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj48Y2lyY2xlIGN4PSIxMiIgY3k9IjEyIiByPSI5IiBzdHJva2U9IiMxRDI2MzIiIHN0cm9rZS13aWR0aD0iMiIvPjxwYXRoIGQ9Ik0xMCA4LjV2N2w2LTMuNS02LTMuNVoiIGZpbGw9IiMxRDI2MzIiLz48L3N2Zz4="
+	// The controller is shown with the Jenkins logo, like jobs.
+	TargetIconController = TargetIconJob
 )
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+// controller is the top-level object of Jenkins, as listed by /api/json.
+type controller struct {
+	QuietingDown bool `json:"quietingDown"`
+	// Version is read from the X-Jenkins header.
+	Version string `json:"-"`
+}
+
+func getController(ctx context.Context, jenkins *gojenkins.Jenkins) (*controller, error) {
+	var response controller
+	query := map[string]string{"tree": "quietingDown"}
+	resp, err := jenkins.Requester.GetJSON(ctx, "/", &response, query)
+	if err != nil {
+		return nil, err
+	}
+	response.Version = resp.Header.Get("X-Jenkins")
+	return &response, nil
+}
+
+type controllerDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*controllerDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*controllerDiscovery)(nil)
+)
+
+func NewControllerDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &controllerDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 5*time.Minute),
+	)
+}
+
+func (d *controllerDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeController,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *controllerDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeController,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconController),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Controller", Other: "Jenkins Controllers"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.controller.url"},
+				{Attribute: "jenkins.controller.version"},
+				{Attribute: "jenkins.controller.node.count"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.controller.url",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *controllerDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.controller.url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Jenkins URL",
+				Other: "Jenkins URLs",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.version",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Jenkins version",
+				Other: "Jenkins versions",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.plugin",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Installed plugin",
+				Other: "Installed plugins",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.plugin.version",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Installed plugin version",
+				Other: "Installed plugin versions",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.plugin.update",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Plugin with update",
+				Other: "Plugins with update",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.executors",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Executors",
+				Other: "Executors",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.quieting-down",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Quieting down",
+				Other: "Quieting down",
+			},
+		},
+		{
+			Attribute: "jenkins.controller.node.count",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node count",
+				Other: "Node counts",
+			},
+		},
+	}
+}
+
+// controllerAttributes lists all attributes of controller targets, which may be excluded through
+// DiscoveryAttributesExcludesController.
+var controllerAttributes = []string{
+	"jenkins.controller.url",
+	"jenkins.controller.version",
+	"jenkins.controller.plugin",
+	"jenkins.controller.plugin.version",
+	"jenkins.controller.plugin.update",
+	"jenkins.controller.executors",
+	"jenkins.controller.quieting-down",
+	"jenkins.controller.node.count",
+}
+
+func (d *controllerDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.controller", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	instance, err := getController(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeController, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch Jenkins.", err)
+	}
+	computers, err := getComputers(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeController, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch nodes.", err)
+	}
+	installed, err := getPlugins(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeController, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch plugins.", err)
+	}
+
+	executors := 0
+	for _, computer := range computers {
+		executors += computer.NumExecutors
+	}
+	target := discovery_kit_api.Target{
+		Id:         config.Config.BaseUrl,
+		TargetType: TargetTypeController,
+		Label:      config.Config.BaseUrl,
+		Attributes: map[string][]string{
+			"jenkins.controller.url":           {config.Config.BaseUrl},
+			"jenkins.controller.executors":     {strconv.Itoa(executors)},
+			"jenkins.controller.quieting-down": {strconv.FormatBool(instance.QuietingDown)},
+			"jenkins.controller.node.count":    {strconv.Itoa(len(computers))},
+		},
+	}
+	if instance.Version != "" {
+		target.Attributes["jenkins.controller.version"] = []string{instance.Version}
+	}
+	// Plugins are missing if the API user may not list them.
+	var names, versions, updates []string
+	for _, p := range installed {
+		// Disabled plugins and plugins failing to load provide no capabilities.
+		if !p.Active {
+			continue
+		}
+		names = append(names, p.ShortName)
+		versions = append(versions, p.ShortName+":"+p.Version)
+		if p.HasUpdate {
+			updates = append(updates, p.ShortName)
+		}
+	}
+	if len(names) > 0 {
+		slices.Sort(names)
+		slices.Sort(versions)
+		target.Attributes["jenkins.controller.plugin"] = names
+		target.Attributes["jenkins.controller.plugin.version"] = versions
+	}
+	if len(updates) > 0 {
+		slices.Sort(updates)
+		target.Attributes["jenkins.controller.plugin.update"] = updates
+	}
+
+	observeDiscovery(TargetTypeController, start, 1, nil)
+	return discovery_kit_commons.ApplyAttributeExcludes([]discovery_kit_api.Target{target}, config.Config.DiscoveryAttributesExcludesController), nil
+}
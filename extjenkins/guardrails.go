@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/steadybit/extension-jenkins/config"
+	extension_kit "github.com/steadybit/extension-kit"
+)
+
+type jobPatterns struct {
+	allowed []*regexp.Regexp
+	denied  []*regexp.Regexp
+}
+
+// guardrailPatterns compiles the allowed and denied job patterns once. The patterns are validated at startup.
+var guardrailPatterns = sync.OnceValue(func() jobPatterns {
+	return jobPatterns{
+		allowed: compileJobPatterns(config.Config.GuardrailsAllowedJobs),
+		denied:  compileJobPatterns(config.Config.GuardrailsDeniedJobs),
+	}
+})
+
+func compileJobPatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		compiled[i] = regexp.MustCompile(config.AnchoredJobPattern(pattern))
+	}
+	return compiled
+}
+
+// checkMutationsAllowed rejects mutating actions if the extension runs in read-only mode.
+func checkMutationsAllowed() error {
+	if config.Config.ReadOnly {
+		return extension_kit.ToError("The Jenkins extension runs in read-only mode, actions changing Jenkins are disabled.", nil)
+	}
+	return nil
+}
+
+// checkScriptsAllowed rejects running Groovy scripts unless explicitly enabled.
+func checkScriptsAllowed() error {
+	if !config.Config.ScriptActionEnabled {
+		return extension_kit.ToError("The extension configuration does not allow to run scripts in the Script Console.", nil)
+	}
+	return nil
+}
+
+// checkJobsAllowed rejects jobs matching a denied pattern or, if allowed patterns are configured, matching none of them.
+func checkJobsAllowed(fullNames ...string) error {
+	patterns := guardrailPatterns()
+	var rejected []string
+	for _, fullName := range fullNames {
+		matches := func(pattern *regexp.Regexp) bool { return pattern.MatchString(fullName) }
+		if slices.ContainsFunc(patterns.denied, matches) || (len(patterns.allowed) > 0 && !slices.ContainsFunc(patterns.allowed, matches)) {
+			rejected = append(rejected, fullName)
+		}
+	}
+	if len(rejected) > 0 {
+		return extension_kit.ToError(fmt.Sprintf("The extension configuration does not allow to run these jobs: %s", strings.Join(rejected, ", ")), nil)
+	}
+	return nil
+}
+
+// checkParametersAllowed rejects overriding protected parameters.
+func checkParametersAllowed(parameters map[string]string) error {
+	var rejected []string
+	for name := range parameters {
+		if slices.Contains(config.Config.GuardrailsProtectedParameters, name) {
+			rejected = append(rejected, name)
+		}
+	}
+	if len(rejected) > 0 {
+		slices.Sort(rejected)
+		return extension_kit.ToError(fmt.Sprintf("The extension configuration does not allow to set these parameters: %s", strings.Join(rejected, ", ")), nil)
+	}
+	return nil
+}
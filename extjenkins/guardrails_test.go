@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"testing"
+
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// useJobPatterns replaces the configured guardrail patterns for the duration of the test.
+func useJobPatterns(t *testing.T, allowed []string, denied []string) {
+	original := guardrailPatterns
+	guardrailPatterns = func() jobPatterns {
+		return jobPatterns{allowed: compileJobPatterns(allowed), denied: compileJobPatterns(denied)}
+	}
+	t.Cleanup(func() { guardrailPatterns = original })
+}
+
+func TestCheckJobsAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		jobs    []string
+		wantErr string
+	}{
+		{name: "no patterns", jobs: []string{"deploy", "team/release"}},
+		{name: "allowed folder", allowed: []string{"chaos/.*"}, jobs: []string{"chaos/deploy", "chaos/team/smoke"}},
+		{name: "outside allowed folder", allowed: []string{"chaos/.*"}, jobs: []string{"chaos/deploy", "deploy"}, wantErr: "The extension configuration does not allow to run these jobs: deploy"},
+		{name: "patterns match the full name", allowed: []string{"chaos"}, jobs: []string{"chaos/deploy", "no-chaos"}, wantErr: "The extension configuration does not allow to run these jobs: chaos/deploy, no-chaos"},
+		{name: "alternatives stay anchored", allowed: []string{"a|b"}, jobs: []string{"a", "b", "ab", "b/c"}, wantErr: "The extension configuration does not allow to run these jobs: ab, b/c"},
+		{name: "denied", denied: []string{".*release.*"}, jobs: []string{"deploy", "team/release"}, wantErr: "The extension configuration does not allow to run these jobs: team/release"},
+		{name: "denied wins over allowed", allowed: []string{"team/.*"}, denied: []string{"team/prod-.*"}, jobs: []string{"team/test-deploy", "team/prod-deploy"}, wantErr: "The extension configuration does not allow to run these jobs: team/prod-deploy"},
+		{name: "no jobs", allowed: []string{"chaos/.*"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useJobPatterns(t, tt.allowed, tt.denied)
+
+			err := checkJobsAllowed(tt.jobs...)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckParametersAllowed(t *testing.T) {
+	tests := []struct {
+		name       string
+		protected  []string
+		parameters map[string]string
+		wantErr    string
+	}{
+		{name: "nothing protected", parameters: map[string]string{"ENV": "prod"}},
+		{name: "unprotected parameters", protected: []string{"ENV"}, parameters: map[string]string{"VERSION": "1.0"}},
+		{name: "protected parameters", protected: []string{"ENV", "REGION"}, parameters: map[string]string{"REGION": "eu", "ENV": "prod", "VERSION": "1.0"}, wantErr: "The extension configuration does not allow to set these parameters: ENV, REGION"},
+		{name: "names are case-sensitive", protected: []string{"ENV"}, parameters: map[string]string{"env": "prod"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Config.GuardrailsProtectedParameters = tt.protected
+			t.Cleanup(func() { config.Config.GuardrailsProtectedParameters = nil })
+
+			err := checkParametersAllowed(tt.parameters)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckMutationsAndScriptsAllowed(t *testing.T) {
+	t.Cleanup(func() { config.Config.ReadOnly, config.Config.ScriptActionEnabled = false, false })
+
+	config.Config.ReadOnly, config.Config.ScriptActionEnabled = false, false
+	assert.NoError(t, checkMutationsAllowed())
+	assert.ErrorContains(t, checkScriptsAllowed(), "does not allow to run scripts")
+
+	config.Config.ReadOnly, config.Config.ScriptActionEnabled = true, true
+	assert.ErrorContains(t, checkMutationsAllowed(), "read-only mode")
+	assert.NoError(t, checkScriptsAllowed())
+}
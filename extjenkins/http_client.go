@@ -0,0 +1,195 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-kit/exthttp"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// NewHttpClient creates the client used for all requests to Jenkins, configured according to config.Config.
+func NewHttpClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	transport.MaxIdleConns = config.Config.HttpMaxIdleConnections
+	transport.MaxIdleConnsPerHost = config.Config.HttpMaxIdleConnections
+
+	keepAlive := time.Duration(config.Config.HttpKeepAliveSeconds) * time.Second
+	if keepAlive <= 0 {
+		keepAlive = -1
+		transport.DisableKeepAlives = true
+	} else {
+		transport.IdleConnTimeout = keepAlive
+	}
+	transport.DialContext = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: keepAlive}).DialContext
+
+	if config.Config.HttpProxy != "" || config.Config.HttpNoProxy != "" {
+		proxyConfig := httpproxy.FromEnvironment()
+		if config.Config.HttpProxy != "" {
+			proxyConfig.HTTPProxy = config.Config.HttpProxy
+			proxyConfig.HTTPSProxy = config.Config.HttpProxy
+		}
+		if config.Config.HttpNoProxy != "" {
+			proxyConfig.NoProxy = config.Config.HttpNoProxy
+		}
+		proxyFunc := proxyConfig.ProxyFunc()
+		transport.Proxy = func(request *http.Request) (*url.URL, error) {
+			return proxyFunc(request.URL)
+		}
+		log.Info().Str("proxy", proxyConfig.HTTPSProxy).Str("noProxy", proxyConfig.NoProxy).Msg("Using proxy for Jenkins connection.")
+	}
+
+	if config.Config.InsecureSkipVerify {
+		log.Info().Msg("TLS verification disabled for Jenkins connection. Self-signed certificates will be accepted.")
+		transport.TLSClientConfig.InsecureSkipVerify = true //NOSONAR explicit choice
+	}
+
+	if config.Config.ClientCertFile != "" || config.Config.ClientKeyFile != "" {
+		if config.Config.ClientCertFile == "" || config.Config.ClientKeyFile == "" {
+			return nil, errors.New("client certificate and key for the Jenkins connection must be configured together")
+		}
+		certificate := &clientCertificate{reloader: exthttp.NewCertReloader(config.Config.ClientCertFile, config.Config.ClientKeyFile)}
+		if _, err := certificate.get(nil); err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.GetClientCertificate = certificate.get
+		log.Info().Str("certFile", config.Config.ClientCertFile).Msg("Using client certificate for Jenkins connection.")
+	}
+
+	timeout := time.Duration(config.Config.HttpTimeoutSeconds) * time.Second
+	var roundTripper http.RoundTripper = transport
+	if !config.Config.InsecureSkipVerify && (config.Config.CaFile != "" || config.Config.CaPem != "") {
+		caTransport := &caReloadingTransport{base: transport, caFile: config.Config.CaFile, caPem: config.Config.CaPem}
+		if _, err := caTransport.transport(); err != nil {
+			return nil, err
+		}
+		log.Info().Str("caFile", config.Config.CaFile).Bool("caPem", config.Config.CaPem != "").Msg("Trusting custom CA certificates for Jenkins connection.")
+		roundTripper = caTransport
+	}
+
+	authenticator, err := newAuthenticator(&http.Client{Transport: roundTripper, Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport: &tracingTransport{delegate: &metricsTransport{delegate: &authenticatingTransport{delegate: roundTripper, authenticator: authenticator}}},
+		Timeout:   timeout,
+	}, nil
+}
+
+// clientCertificate provides the client certificate for mutual TLS. The certificate is reloaded when the key file
+// changes, e.g. when a mounted secret is rotated.
+type clientCertificate struct {
+	mu       sync.Mutex
+	reloader *exthttp.CertReloader
+}
+
+func (c *clientCertificate) get(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reloader.GetCertificate(nil)
+}
+
+// caReloadingTransport trusts the configured CA certificates in addition to the system roots. Whenever the CA file
+// changes, the underlying transport is replaced, so a rotated CA bundle is used without restarting the extension.
+type caReloadingTransport struct {
+	base   *http.Transport
+	caFile string
+	caPem  string
+
+	mu      sync.Mutex
+	current *http.Transport
+	modTime time.Time
+}
+
+func (t *caReloadingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	transport, err := t.transport()
+	if err != nil {
+		return nil, err
+	}
+	return transport.RoundTrip(request)
+}
+
+func (t *caReloadingTransport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.current != nil {
+		t.current.CloseIdleConnections()
+	}
+}
+
+func (t *caReloadingTransport) transport() (*http.Transport, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var modTime time.Time
+	if t.caFile != "" {
+		stat, err := os.Stat(t.caFile)
+		if err != nil {
+			if t.current != nil {
+				log.Warn().Err(err).Msg("Failed to check CA file for changes. Keeping the current CA certificates.")
+				return t.current, nil
+			}
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		modTime = stat.ModTime()
+	}
+	if t.current != nil && !modTime.After(t.modTime) {
+		return t.current, nil
+	}
+
+	pool, err := t.loadCertPool()
+	if err != nil {
+		if t.current != nil {
+			log.Warn().Err(err).Msg("Failed to reload CA certificates. Keeping the current CA certificates.")
+			return t.current, nil
+		}
+		return nil, err
+	}
+
+	transport := t.base.Clone()
+	transport.TLSClientConfig.RootCAs = pool
+	if t.current != nil {
+		t.current.CloseIdleConnections()
+		log.Info().Str("caFile", t.caFile).Msg("Reloaded CA certificates for Jenkins connection.")
+	}
+	t.current = transport
+	t.modTime = modTime
+	return transport, nil
+}
+
+func (t *caReloadingTransport) loadCertPool() (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to load system CA certificates. Trusting only the configured CA certificates.")
+		pool = x509.NewCertPool()
+	}
+	if t.caFile != "" {
+		pem, err := os.ReadFile(t.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", t.caFile)
+		}
+	}
+	if t.caPem != "" && !pool.AppendCertsFromPEM([]byte(t.caPem)) {
+		return nil, errors.New("no certificates found in the configured CA PEM")
+	}
+	return pool, nil
+}
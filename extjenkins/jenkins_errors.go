@@ -0,0 +1,82 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	extension_kit "github.com/steadybit/extension-kit"
+)
+
+// JenkinsApiError is returned for Jenkins requests answered with an error status or not answered at all.
+type JenkinsApiError struct {
+	Endpoint string
+	// StatusCode is 0 if no response was received, e.g. on timeouts or refused connections.
+	StatusCode int
+	Cause      error
+}
+
+func (e *JenkinsApiError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("request to %s failed: %s", e.Endpoint, e.Cause)
+	}
+	return fmt.Sprintf("request to %s failed with status %d %s", e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *JenkinsApiError) Unwrap() error {
+	return e.Cause
+}
+
+// IsTransient reports whether the request may succeed when repeated later.
+func (e *JenkinsApiError) IsTransient() bool {
+	return e.StatusCode == 0 || e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// toJenkinsError converts an error of a Jenkins request into an ExtensionError. Transient errors are reported as
+// Jenkins being temporarily unavailable, authentication and authorization errors get a title pointing to the cause.
+func toJenkinsError(title string, err error) extension_kit.ExtensionError {
+	var authErr *AuthenticationError
+	if errors.As(err, &authErr) {
+		detail := fmt.Sprintf("%s %s", title, authErr.Error())
+		return extension_kit.ExtensionError{Title: "Failed to obtain credentials for Jenkins. Please check the authentication settings.", Detail: &detail}
+	}
+	var apiErr *JenkinsApiError
+	if !errors.As(err, &apiErr) {
+		return extension_kit.ToError(title, err)
+	}
+	detail := fmt.Sprintf("%s %s", title, apiErr.Error())
+	switch {
+	case apiErr.IsTransient():
+		return extension_kit.ExtensionError{Title: "Jenkins temporarily unavailable.", Detail: &detail}
+	case apiErr.StatusCode == http.StatusUnauthorized:
+		return extension_kit.ExtensionError{Title: "Jenkins rejected the credentials. Please check the configured API user and token.", Detail: &detail}
+	case apiErr.StatusCode == http.StatusForbidden:
+		return extension_kit.ExtensionError{Title: "The Jenkins API user lacks the permission for this request.", Detail: &detail}
+	case apiErr.StatusCode == http.StatusNotFound:
+		return extension_kit.ExtensionError{Title: fmt.Sprintf("%s Not found in Jenkins.", title), Detail: &detail}
+	default:
+		return extension_kit.ToError(title, err)
+	}
+}
+
+// isTransient reports whether a Jenkins request failed for a reason that may go away, like a 502 from a proxy.
+func isTransient(err error) bool {
+	var apiErr *JenkinsApiError
+	return errors.As(err, &apiErr) && apiErr.IsTransient()
+}
+
+// isNotFound reports whether Jenkins answered a request with 404 Not Found, e.g. because a plugin is not installed.
+func isNotFound(err error) bool {
+	var apiErr *JenkinsApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// isForbidden reports whether Jenkins answered a request with 403 Forbidden, because the API user lacks a permission.
+func isForbidden(err error) bool {
+	var apiErr *JenkinsApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
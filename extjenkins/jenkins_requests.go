@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+)
+
+// postForm submits a form to Jenkins. Jenkins answers most form submissions with a redirect to an HTML page, which
+// gojenkins fails to decode as JSON. The submission succeeded nevertheless if a non-error status was received. Error
+// statuses are already turned into JenkinsApiErrors by the requester, see NewRetryingRequester.
+func postForm(ctx context.Context, jenkins *gojenkins.Jenkins, endpoint string, data url.Values, query map[string]string) (*http.Response, error) {
+	response, err := jenkins.Requester.Post(ctx, endpoint, bytes.NewBufferString(data.Encode()), nil, query)
+	var apiErr *JenkinsApiError
+	if response == nil || errors.As(err, &apiErr) {
+		return response, err
+	}
+	return response, nil
+}
+
+// postXML submits an XML document to Jenkins, like the configuration of a node. Like for postForm, the submission
+// succeeded if a non-error status was received.
+func postXML(ctx context.Context, jenkins *gojenkins.Jenkins, endpoint string, xml string) (*http.Response, error) {
+	response, err := jenkins.Requester.PostXML(ctx, endpoint, xml, nil, nil)
+	var apiErr *JenkinsApiError
+	if response == nil || errors.As(err, &apiErr) {
+		return response, err
+	}
+	return response, nil
+}
+
+// queueBuild triggers a build of a parameterized job and returns the id of the queue item. Unlike job.InvokeSimple,
+// it triggers the build also while the job is queued, as Jenkins queues builds with distinct parameters separately.
+func queueBuild(ctx context.Context, jenkins *gojenkins.Jenkins, job *gojenkins.Job, parameters map[string]string) (int64, error) {
+	data := url.Values{}
+	for name, value := range parameters {
+		data.Set(name, value)
+	}
+	response, err := postForm(ctx, jenkins, job.Base+"/buildWithParameters", data, nil)
+	if err != nil {
+		return 0, err
+	}
+	// Jenkins answers with the url of the queue item, like 'https://jenkins/queue/item/4711/'.
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid location of queue item: %w", err)
+	}
+	queueId, err := strconv.ParseInt(path.Base(location.Path), 10, 64)
+	if err != nil || !strings.Contains(location.Path, "/queue/item/") {
+		return 0, fmt.Errorf("no queue item in location '%s'", location)
+	}
+	return queueId, nil
+}
@@ -3,11 +3,12 @@ package extjenkins
 import (
 	"context"
 	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
 	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
 	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
 	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
 	"github.com/steadybit/extension-jenkins/config"
-	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-jenkins/exttracing"
 	"github.com/steadybit/extension-kit/extbuild"
 	"time"
 )
@@ -85,6 +86,20 @@ func (d *jobDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescrip
 				Other: "Job display names",
 			},
 		},
+		{
+			Attribute: "jenkins.job.parameter.secret",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Job secret parameter",
+				Other: "Job secret parameters",
+			},
+		},
+		{
+			Attribute: "jenkins.job.view",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Job view",
+				Other: "Job views",
+			},
+		},
 		{
 			Attribute: "jenkins.job.url",
 			Label: discovery_kit_api.PluralLabel{
@@ -95,10 +110,32 @@ func (d *jobDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescrip
 	}
 }
 
-func (d *jobDiscovery) DiscoverTargets(ctx context.Context) ([]discovery_kit_api.Target, error) {
+// jobAttributes lists all attributes of job targets, which may be excluded through DiscoveryAttributesExcludesJob.
+var jobAttributes = []string{
+	"jenkins.job.name",
+	"jenkins.job.name.full",
+	"jenkins.job.name.full.display",
+	"jenkins.job.url",
+	"jenkins.job.class",
+	"jenkins.job.parameter",
+	"jenkins.job.parameter.secret",
+	"jenkins.job.view",
+}
+
+func (d *jobDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.jobs", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
 	jobs, err := getAllJobsRecursive(ctx, d.jenkins)
 	if err != nil {
-		return nil, extension_kit.ToError("Failed to fetch jobs.", err)
+		observeDiscovery(TargetTypeJob, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch jobs.", err)
+	}
+	// Jobs are discovered even if the views cannot be read, just without their views.
+	views, err := getViews(ctx, d.jenkins)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to fetch views, jobs are discovered without their views.")
 	}
 
 	targets := make([]discovery_kit_api.Target, len(jobs))
@@ -127,7 +164,14 @@ func (d *jobDiscovery) DiscoverTargets(ctx context.Context) ([]discovery_kit_api
 			}
 			targets[i].Attributes["jenkins.job.parameter"] = parameterAttribute
 		}
+		if secrets := secretParametersOfJob(job); len(secrets) > 0 {
+			targets[i].Attributes["jenkins.job.parameter.secret"] = secrets
+		}
+		if jobViews := viewsOfJob(views, job.GetDetails().URL); len(jobViews) > 0 {
+			targets[i].Attributes["jenkins.job.view"] = jobViews
+		}
 	}
+	observeDiscovery(TargetTypeJob, start, len(targets), nil)
 	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesJob), nil
 }
 
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDiscoveryViews(t *testing.T) {
+	tests := []struct {
+		name      string
+		views     int
+		wantViews []string
+	}{
+		{name: "views", views: http.StatusOK, wantViews: []string{"Payments"}},
+		{name: "views denied", views: http.StatusForbidden},
+		{name: "views failing", views: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/json" && strings.HasPrefix(r.URL.Query().Get("tree"), "views["):
+					w.WriteHeader(tt.views)
+					_, _ = w.Write([]byte(`{"views":[{"_class":"hudson.model.ListView","name":"Payments","url":"` + server.URL + `/view/Payments/","jobs":[{"name":"deploy","url":"` + server.URL + `/job/deploy/"}]}]}`))
+				case r.URL.Path == "/api/json":
+					_, _ = w.Write([]byte(`{"jobs":[{"_class":"hudson.model.FreeStyleProject","name":"deploy","url":"` + server.URL + `/job/deploy/"}]}`))
+				case r.URL.Path == "/job/deploy/api/json":
+					_, _ = w.Write([]byte(`{"_class":"hudson.model.FreeStyleProject","name":"deploy","fullName":"deploy","fullDisplayName":"deploy","url":"` + server.URL + `/job/deploy/"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+			jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+
+			targets, err := (&jobDiscovery{jenkins: jenkins}).DiscoverTargets(t.Context())
+
+			require.NoError(t, err)
+			require.Len(t, targets, 1)
+			assert.Equal(t, []string{"deploy"}, targets[0].Attributes["jenkins.job.name.full"])
+			assert.Equal(t, tt.wantViews, targets[0].Attributes["jenkins.job.view"])
+		})
+	}
+}
@@ -0,0 +1,392 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const (
+	jobFloodQueueActionId = TargetTypeJob + ".flood-queue"
+	// floodStopConcurrency limits the requests sent concurrently to cancel and abort the builds on stop.
+	floodStopConcurrency = 10
+)
+
+type jobFloodQueueAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[JobFloodQueueActionState]           = (*jobFloodQueueAction)(nil)
+	_ action_kit_sdk.ActionWithStatus[JobFloodQueueActionState] = (*jobFloodQueueAction)(nil)
+	_ action_kit_sdk.ActionWithStop[JobFloodQueueActionState]   = (*jobFloodQueueAction)(nil)
+)
+
+type JobFloodQueueActionState struct {
+	JobName           string
+	ParentIds         []string
+	Count             int
+	Interval          time.Duration
+	DistinctParameter string
+	Parameters        map[string]string
+	SecretParameters  []string
+	StartedAt         time.Time
+	// QueueIds of all builds queued so far, 0 for builds Jenkins merged into a build already queued.
+	QueueIds    []int64
+	Counted     bool
+	Correlation BuildCorrelation
+}
+
+func NewJobFloodQueueAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[JobFloodQueueActionState] {
+	return &jobFloodQueueAction{jenkins: jenkins}
+}
+
+func (a *jobFloodQueueAction) NewEmptyState() JobFloodQueueActionState {
+	return JobFloodQueueActionState{}
+}
+
+func (a *jobFloodQueueAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          jobFloodQueueActionId,
+		Label:       "Flood Build Queue",
+		Description: "Queues many builds of a job at a given rate to put load on the build queue. All builds still queued are canceled and all started builds are aborted when the step ends.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconJob),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeJob,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "job name",
+					Query: "jenkins.job.name=\"\"",
+				},
+			}),
+			QuantityRestriction: extutil.Ptr(action_kit_api.QuantityRestrictionExactlyOne),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long builds are queued. When the step ends, all builds still queued are canceled and all started builds are aborted."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "count",
+				Label:        "Number of Builds",
+				Description:  new("How many builds to queue."),
+				Type:         action_kit_api.ActionParameterTypeInteger,
+				DefaultValue: new("20"),
+				MinValue:     new(1),
+				MaxValue:     new(1000),
+				Required:     new(true),
+			},
+			{
+				Name:         "interval",
+				Label:        "Interval",
+				Description:  new("Time between queuing two builds. With 0, all builds are queued at once."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("1s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "distinctParameter",
+				Label:        "Distinct Parameter",
+				Description:  new("Build parameter of the job receiving a distinct value for every build. Jenkins merges queued builds with equal parameters, so the job must define this parameter."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("STEADYBIT_FLOOD_ID"),
+				Required:     new(true),
+			},
+			{
+				Name:        "parameters",
+				Label:       "Parameters",
+				Description: new("Optional parameters to pass to every build. Use `${secret:NAME}` as value to pass a secret provided to the extension, the value then never appears in the experiment."),
+				Type:        action_kit_api.ActionParameterTypeKeyValue,
+				Required:    new(false),
+				Advanced:    new(true),
+			},
+		},
+		Status: new(action_kit_api.MutatingEndpointReferenceWithCallInterval{
+			CallInterval: new("1s"),
+		}),
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *jobFloodQueueAction) Prepare(ctx context.Context, state *JobFloodQueueActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startJobSpan(ctx, "jenkins.job.flood-queue.prepare", newBuildCorrelation(request.ExecutionContext), request.Target.Name)
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	fullName := extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name.full")[0]
+	if err = checkJobsAllowed(fullName); err != nil {
+		return nil, err
+	}
+
+	state.JobName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name")[0]
+	state.ParentIds = extractParentIds(fullName)
+	state.Count = extutil.ToInt(request.Config["count"])
+	if state.Count < 1 {
+		return nil, extension_kit.ToError("The number of builds must be at least 1.", nil)
+	}
+	state.Interval = time.Duration(extutil.ToInt64(request.Config["interval"])) * time.Millisecond
+	state.DistinctParameter = extutil.ToString(request.Config["distinctParameter"])
+	if !slices.Contains(request.Target.Attributes["jenkins.job.parameter"], state.DistinctParameter) {
+		return nil, extension_kit.ToError(fmt.Sprintf("The job does not define the parameter '%s'. Jenkins merges queued builds with equal parameters, so the job needs a parameter receiving a distinct value for every build.", state.DistinctParameter), nil)
+	}
+	state.Parameters = map[string]string{}
+	if request.Config["parameters"] != nil {
+		state.Parameters, err = extutil.ToKeyValue(request.Config, "parameters")
+		if err != nil {
+			return nil, err
+		}
+		state.SecretParameters, err = checkSecretParameters(state.Parameters, request.Target.Attributes["jenkins.job.parameter.secret"])
+		if err != nil {
+			return nil, err
+		}
+	}
+	setParameters := maps.Clone(state.Parameters)
+	setParameters[state.DistinctParameter] = ""
+	if err = checkParametersAllowed(setParameters); err != nil {
+		return nil, err
+	}
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *jobFloodQueueAction) Start(ctx context.Context, state *JobFloodQueueActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.flood-queue.start", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+
+	log.Info().Str("jobName", state.JobName).Strs("parentIds", state.ParentIds).Int("count", state.Count).
+		Dur("interval", state.Interval).Msg("Flooding build queue.")
+	state.StartedAt = time.Now()
+	messages, err := a.queueDueBuilds(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	return &action_kit_api.StartResult{
+		Messages: &messages,
+	}, nil
+}
+
+func (a *jobFloodQueueAction) Status(ctx context.Context, state *JobFloodQueueActionState) (_ *action_kit_api.StatusResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.flood-queue.status", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+
+	messages, err := a.queueDueBuilds(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	return &action_kit_api.StatusResult{
+		Completed: false,
+		Messages:  &messages,
+	}, nil
+}
+
+// queueDueBuilds queues the builds due according to the interval since the start.
+func (a *jobFloodQueueAction) queueDueBuilds(ctx context.Context, state *JobFloodQueueActionState) ([]action_kit_api.Message, error) {
+	due := floodBuildsDue(state.Count, state.Interval, time.Since(state.StartedAt))
+	if len(state.QueueIds) >= due {
+		return nil, nil
+	}
+
+	ctx = withAuditSubject(ctx, state.Correlation, fullJobName(state.JobName, state.ParentIds), "")
+	ctx = withSecretParameters(ctx, state.SecretParameters)
+	parameters, err := resolveSecretReferences(state.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	job, err := a.jenkins.GetJob(ctx, state.JobName, state.ParentIds...)
+	if err != nil {
+		return nil, toJenkinsError("Failed to find job.", err)
+	}
+
+	merged := 0
+	for i := len(state.QueueIds); i < due; i++ {
+		buildParameters := make(map[string]string)
+		maps.Copy(buildParameters, state.Correlation.withParameters(job, parameters))
+		buildParameters[state.DistinctParameter] = fmt.Sprintf("steadybit-%s-%d-%d", state.Correlation.ExperimentKey, state.Correlation.ExecutionId, i+1)
+		queueId, err := queueBuild(ctx, a.jenkins, job, buildParameters)
+		if err != nil {
+			return nil, toJenkinsError("Failed to queue job.", err)
+		}
+		// Jenkins answers with the queue item of an equal build if it merged the build into it.
+		if slices.Contains(state.QueueIds, queueId) {
+			merged++
+			queueId = 0
+		}
+		state.QueueIds = append(state.QueueIds, queueId)
+	}
+	if !state.Counted && slices.ContainsFunc(state.QueueIds, func(queueId int64) bool { return queueId != 0 }) {
+		metricActiveJobRuns.WithLabelValues(jobFloodQueueActionId).Inc()
+		state.Counted = true
+	}
+
+	log.Info().Int("queued", len(state.QueueIds)).Int("count", state.Count).Msg("Builds queued.")
+	message := fmt.Sprintf("- Queued %d of %d builds.", len(state.QueueIds), state.Count)
+	if merged > 0 {
+		message += fmt.Sprintf(" %d were merged with builds already queued ⚠️", merged)
+	}
+	return []action_kit_api.Message{
+		{
+			Message: message,
+			Type:    new("JENKINS"),
+		},
+	}, nil
+}
+
+// floodBuildsDue returns how many builds are due the given time after the start. The first build is due at once.
+func floodBuildsDue(count int, interval time.Duration, elapsed time.Duration) int {
+	if interval <= 0 {
+		return count
+	}
+	return min(count, int(elapsed/interval)+1)
+}
+
+func (a *jobFloodQueueAction) Stop(ctx context.Context, state *JobFloodQueueActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.flood-queue.stop", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, fullJobName(state.JobName, state.ParentIds), "")
+
+	if state.Counted {
+		metricActiveJobRuns.WithLabelValues(jobFloodQueueActionId).Dec()
+		state.Counted = false
+	}
+	if !slices.ContainsFunc(state.QueueIds, func(queueId int64) bool { return queueId != 0 }) {
+		return nil, nil
+	}
+
+	job, err := a.jenkins.GetJob(ctx, state.JobName, state.ParentIds...)
+	if err != nil {
+		return nil, toJenkinsError("Failed to find job.", err)
+	}
+	var lowestQueueId int64
+	for _, queueId := range state.QueueIds {
+		if queueId != 0 && (lowestQueueId == 0 || queueId < lowestQueueId) {
+			lowestQueueId = queueId
+		}
+	}
+	// The builds are only searched once some queue items were already removed from the queue.
+	runIds := sync.OnceValues(func() (map[int64]int64, error) {
+		return findRunIdsSinceQueueId(ctx, job, lowestQueueId)
+	})
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		canceled  int
+		aborted   int
+		failedIds []int64
+		errs      []string
+		semaphore = make(chan struct{}, floodStopConcurrency)
+	)
+	for _, queueId := range state.QueueIds {
+		if queueId == 0 {
+			continue
+		}
+		wg.Go(func() {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			wasCanceled, wasAborted, err := a.stopBuild(ctx, job, runIds, queueId)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				failedIds = append(failedIds, queueId)
+				errs = append(errs, fmt.Sprintf("queue item %d: %s", queueId, err.Error()))
+			case wasCanceled:
+				canceled++
+			case wasAborted:
+				aborted++
+			}
+		})
+	}
+	wg.Wait()
+	// A retried stop only handles the builds that could not be stopped.
+	slices.Sort(failedIds)
+	state.QueueIds = failedIds
+
+	log.Info().Int("canceled", canceled).Int("aborted", aborted).Msg("Build queue flood stopped.")
+	if len(errs) > 0 {
+		return nil, extension_kit.ToError("Failed to stop builds.", fmt.Errorf("%s", strings.Join(errs, "; ")))
+	}
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Canceled %d queued builds and aborted %d started builds. 🛑", canceled, aborted),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+// stopBuild cancels the queue item or aborts the build it started. Jenkins removes items from the queue a few minutes
+// after they left it, those are looked up among the builds started since the flood began. An item neither in the queue
+// nor among these builds was canceled or its build was deleted, so there is nothing left to stop.
+func (a *jobFloodQueueAction) stopBuild(ctx context.Context, job *gojenkins.Job, runIds func() (map[int64]int64, error), queueId int64) (canceled bool, aborted bool, err error) {
+	var runId int64
+	task, err := a.jenkins.GetQueueItem(ctx, queueId)
+	switch {
+	case err == nil:
+		runId = task.Raw.Executable.Number
+		if runId == 0 {
+			if _, err = task.Cancel(ctx); err != nil {
+				return false, false, toJenkinsError("Failed to cancel the task.", err)
+			}
+			return true, false, nil
+		}
+	case isNotFound(err):
+		started, err := runIds()
+		if err != nil {
+			return false, false, toJenkinsError("Failed to fetch builds.", err)
+		}
+		if runId = started[queueId]; runId == 0 {
+			return false, false, nil
+		}
+	default:
+		return false, false, toJenkinsError("Failed to fetch task.", err)
+	}
+	build, err := job.GetBuild(ctx, runId)
+	if err != nil {
+		return false, false, toJenkinsError("Failed to fetch build.", err)
+	}
+	if !build.Raw.Building {
+		return false, false, nil
+	}
+	stopped, err := build.Stop(ctx)
+	if err != nil {
+		return false, false, toJenkinsError("Failed to stop build.", err)
+	}
+	return false, stopped, nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloodBuildsDue(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		interval time.Duration
+		elapsed  time.Duration
+		want     int
+	}{
+		{name: "all at once", count: 20, want: 20},
+		{name: "first build at start", count: 20, interval: time.Second, want: 1},
+		{name: "before the interval", count: 20, interval: time.Second, elapsed: 999 * time.Millisecond, want: 1},
+		{name: "after the interval", count: 20, interval: time.Second, elapsed: time.Second, want: 2},
+		{name: "after some intervals", count: 20, interval: 500 * time.Millisecond, elapsed: 2200 * time.Millisecond, want: 5},
+		{name: "limited by count", count: 3, interval: time.Second, elapsed: time.Minute, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, floodBuildsDue(tt.count, tt.interval, tt.elapsed))
+		})
+	}
+}
+
+// startFloodServer serves a queued parameterized job. It answers the n-th build request with the queue item returned
+// by queueItem and records the distinct parameter of every build request.
+func startFloodServer(t *testing.T, queueItem func(n int) int) (*gojenkins.Jenkins, func() []string) {
+	var (
+		mu     sync.Mutex
+		builds []string
+	)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/job/deploy/api/json":
+			_, _ = w.Write([]byte(`{"name":"deploy","url":"` + server.URL + `/job/deploy/","inQueue":true,
+			  "property":[{"parameterDefinitions":[{"name":"STEADYBIT_FLOOD_ID","type":"StringParameterDefinition"}]}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/job/deploy/buildWithParameters":
+			require.NoError(t, r.ParseForm())
+			mu.Lock()
+			builds = append(builds, r.PostForm.Get("STEADYBIT_FLOOD_ID"))
+			n := len(builds)
+			mu.Unlock()
+			w.Header().Set("Location", fmt.Sprintf("%s/queue/item/%d/", server.URL, queueItem(n)))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+	jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+	return jenkins, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return builds
+	}
+}
+
+func TestFloodQueuesBuildsWhileJobIsQueued(t *testing.T) {
+	jenkins, builds := startFloodServer(t, func(n int) int { return 100 + n })
+	action := &jobFloodQueueAction{jenkins: jenkins}
+	state := &JobFloodQueueActionState{JobName: "deploy", Count: 5, DistinctParameter: "STEADYBIT_FLOOD_ID", Parameters: map[string]string{},
+		Correlation: BuildCorrelation{ExperimentKey: "ADM-1", ExecutionId: 42}}
+
+	result, err := action.Start(t.Context(), state)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"steadybit-ADM-1-42-1", "steadybit-ADM-1-42-2", "steadybit-ADM-1-42-3", "steadybit-ADM-1-42-4", "steadybit-ADM-1-42-5",
+	}, builds(), "every build is requested, although the job is queued")
+	assert.Equal(t, []int64{101, 102, 103, 104, 105}, state.QueueIds)
+	assert.Equal(t, "- Queued 5 of 5 builds.", (*result.Messages)[0].Message)
+
+	status, err := action.Status(t.Context(), state)
+	require.NoError(t, err)
+	assert.Empty(t, *status.Messages)
+	assert.Len(t, builds(), 5, "no builds are requested once all are queued")
+	metricActiveJobRuns.WithLabelValues(jobFloodQueueActionId).Dec()
+}
+
+func TestFloodDetectsMergedBuilds(t *testing.T) {
+	jenkins, builds := startFloodServer(t, func(n int) int { return min(n, 2) })
+	action := &jobFloodQueueAction{jenkins: jenkins}
+	state := &JobFloodQueueActionState{JobName: "deploy", Count: 4, DistinctParameter: "STEADYBIT_FLOOD_ID", Parameters: map[string]string{}}
+
+	result, err := action.Start(t.Context(), state)
+
+	require.NoError(t, err)
+	assert.Len(t, builds(), 4)
+	assert.Equal(t, []int64{1, 2, 0, 0}, state.QueueIds)
+	assert.Equal(t, "- Queued 4 of 4 builds. 2 were merged with builds already queued ⚠️", (*result.Messages)[0].Message)
+	metricActiveJobRuns.WithLabelValues(jobFloodQueueActionId).Dec()
+}
+
+func TestFloodQueuesDueBuildsOnly(t *testing.T) {
+	jenkins, builds := startFloodServer(t, func(n int) int { return n })
+	action := &jobFloodQueueAction{jenkins: jenkins}
+	state := &JobFloodQueueActionState{JobName: "deploy", Count: 10, Interval: time.Second, DistinctParameter: "STEADYBIT_FLOOD_ID", Parameters: map[string]string{}}
+
+	_, err := action.Start(t.Context(), state)
+	require.NoError(t, err)
+	assert.Len(t, builds(), 1)
+
+	state.StartedAt = state.StartedAt.Add(-3500 * time.Millisecond)
+	_, err = action.Status(t.Context(), state)
+	require.NoError(t, err)
+	assert.Len(t, builds(), 4)
+	assert.Equal(t, []int64{1, 2, 3, 4}, state.QueueIds)
+	metricActiveJobRuns.WithLabelValues(jobFloodQueueActionId).Dec()
+}
+
+func TestFloodStopFindsEvictedQueueItems(t *testing.T) {
+	var stopped []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/job/deploy/api/json" && r.URL.Query().Get("tree") == "":
+			_, _ = w.Write([]byte(`{"name":"deploy","url":"` + server.URL + `/job/deploy"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/job/deploy/api/json":
+			// Builds 1 to 120 were started for the queue items 1001 to 1120, the flood started with queue item 1010.
+			var builds []string
+			for number := 120; number >= 1; number-- {
+				builds = append(builds, fmt.Sprintf(`{"number":%d,"queueId":%d}`, number, 1000+number))
+			}
+			var from, to int
+			_, err := fmt.Sscanf(r.URL.Query().Get("tree"), "allBuilds[number,queueId]{%d,%d}", &from, &to)
+			require.NoError(t, err)
+			_, _ = w.Write([]byte(`{"allBuilds":[` + strings.Join(builds[from:min(to, len(builds))], ",") + `]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/queue/item/2000/api/json":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Method == http.MethodGet && r.URL.Path == "/job/deploy/10/api/json":
+			_, _ = w.Write([]byte(`{"number":10,"building":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/job/deploy/10/stop":
+			stopped = append(stopped, r.URL.Path)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+	jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+	action := &jobFloodQueueAction{jenkins: jenkins}
+	state := &JobFloodQueueActionState{JobName: "deploy", QueueIds: []int64{2000, 1010, 0, 1500}}
+
+	result, err := action.Stop(t.Context(), state)
+
+	assert.ErrorContains(t, err, "Failed to stop builds.")
+	assert.Nil(t, result)
+	assert.Equal(t, []string{"/job/deploy/10/stop"}, stopped, "the build of the evicted queue item is aborted")
+	assert.Equal(t, []int64{2000}, state.QueueIds, "a retried stop handles the failed queue item only")
+}
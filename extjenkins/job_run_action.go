@@ -36,14 +36,24 @@ type JobRunActionState struct {
 	ParentIds         []string
 	WaitForCompletion bool
 	Parameters        map[string]string
+	SecretParameters  []string
 	QueueId           int64
 	RunId             int64
 	DontStop          bool
+	SkipIfRunning     bool
+	Adopted           bool
+	Counted           bool
+	Correlation       BuildCorrelation
 	TimeoutOffset     time.Duration `json:"timeoutOffset"`
 }
 
 var referenceTime = time.Now()
 
+const (
+	jobRunActionId       = TargetTypeJob + ".run"
+	adoptableBuildsLimit = 10
+)
+
 func NewJobRunAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[JobRunActionState] {
 	return &jobRunAction{jenkins: jenkins}
 }
@@ -54,7 +64,7 @@ func (l *jobRunAction) NewEmptyState() JobRunActionState {
 
 func (l *jobRunAction) Describe() action_kit_api.ActionDescription {
 	return action_kit_api.ActionDescription{
-		Id:          fmt.Sprintf("%s.run", TargetTypeJob),
+		Id:          jobRunActionId,
 		Label:       "Run Jenkins Job",
 		Description: "Starts a Jenkins job.",
 		Version:     extbuild.GetSemverVersionStringOrUnknown(),
@@ -92,10 +102,19 @@ func (l *jobRunAction) Describe() action_kit_api.ActionDescription {
 			{
 				Name:        "parameters",
 				Label:       "Parameters",
-				Description: new("Optional parameters to pass to the job."),
+				Description: new("Optional parameters to pass to the job. Use `${secret:NAME}` as value to pass a secret provided to the extension, the value then never appears in the experiment."),
 				Type:        action_kit_api.ActionParameterTypeKeyValue,
 				Required:    new(false),
 			},
+			{
+				Name:         "skipIfRunning",
+				Label:        "Skip if already running or queued",
+				Description:  new("If enabled, the action attaches to a build of this job that is already running or queued with the same parameters instead of queuing a new one. Attached builds are not stopped when the step is stopped."),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("false"),
+				Required:     new(false),
+				Advanced:     new(true),
+			},
 		},
 		Status: new(action_kit_api.MutatingEndpointReferenceWithCallInterval{
 			CallInterval: new("2s"),
@@ -112,18 +131,37 @@ func (l *jobRunAction) Describe() action_kit_api.ActionDescription {
 	}
 }
 
-func (l *jobRunAction) Prepare(_ context.Context, state *JobRunActionState, request action_kit_api.PrepareActionRequestBody) (*action_kit_api.PrepareResult, error) {
+func (l *jobRunAction) Prepare(ctx context.Context, state *JobRunActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startJobSpan(ctx, "jenkins.job.run.prepare", newBuildCorrelation(request.ExecutionContext), request.Target.Name)
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	fullName := extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name.full")[0]
+	if err = checkJobsAllowed(fullName); err != nil {
+		return nil, err
+	}
+
 	state.JobName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name")[0]
-	state.ParentIds = extractParentIds(extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name.full")[0])
+	state.ParentIds = extractParentIds(fullName)
 	state.WaitForCompletion = extutil.ToBool(request.Config["waitForCompletion"])
+	state.SkipIfRunning = extutil.ToBool(request.Config["skipIfRunning"])
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
 	jobStartTimeout := time.Duration(int(time.Second) * config.Config.JobStartTimeoutSeconds)
 	state.TimeoutOffset = time.Since(referenceTime) + jobStartTimeout
 	if (request.Config["parameters"]) != nil {
-		var err error
 		state.Parameters, err = extutil.ToKeyValue(request.Config, "parameters")
 		if err != nil {
 			return nil, err
 		}
+		if err = checkParametersAllowed(state.Parameters); err != nil {
+			return nil, err
+		}
+		state.SecretParameters, err = checkSecretParameters(state.Parameters, request.Target.Attributes["jenkins.job.parameter.secret"])
+		if err != nil {
+			return nil, err
+		}
 
 		availableParameters, hasParams := request.Target.Attributes["jenkins.job.parameter"]
 		if (!hasParams || len(availableParameters) == 0) && len(state.Parameters) > 0 {
@@ -168,20 +206,49 @@ func extractParentIds(fullName string) []string {
 	return parts[:len(parts)-1]
 }
 
-func (l *jobRunAction) Start(ctx context.Context, state *JobRunActionState) (*action_kit_api.StartResult, error) {
-	log.Info().Str("jobName", state.JobName).Strs("parentIds", state.ParentIds).Msg("Starting job.")
+func (l *jobRunAction) Start(ctx context.Context, state *JobRunActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run.start", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, fullJobName(state.JobName, state.ParentIds), "")
+	ctx = withSecretParameters(ctx, state.SecretParameters)
+
+	log.Info().Str("jobName", state.JobName).Strs("parentIds", state.ParentIds).
+		Interface("parameters", redactParameters(state.Parameters, state.SecretParameters)).
+		Msg("Starting job.")
+
+	parameters, err := resolveSecretReferences(state.Parameters)
+	if err != nil {
+		return nil, err
+	}
 
 	job, err := l.jenkins.GetJob(ctx, state.JobName, state.ParentIds...)
 	if err != nil {
-		return nil, extension_kit.ToError("Failed to find job.", err)
+		return nil, toJenkinsError("Failed to find job.", err)
+	}
+
+	if state.SkipIfRunning {
+		result, err := l.adoptInProgressBuild(ctx, job, state, parameters)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			metricActiveJobRuns.WithLabelValues(jobRunActionId).Inc()
+			state.Counted = true
+			return result, nil
+		}
 	}
 
-	queueId, err := job.InvokeSimple(ctx, state.Parameters)
+	queueId, err := job.InvokeSimple(ctx, state.Correlation.withParameters(job, parameters))
 	if err != nil {
-		return nil, extension_kit.ToError("Failed to queue job.", err)
+		return nil, toJenkinsError("Failed to queue job.", err)
+	}
+	if queueId == 0 {
+		return nil, extension_kit.ToError("Job is already queued. Enable 'Skip if already running or queued' to attach to the queued build.", nil)
 	}
 	log.Info().Int64("queueId", queueId).Msg("Job queued successfully.")
 	state.QueueId = queueId
+	metricActiveJobRuns.WithLabelValues(jobRunActionId).Inc()
+	state.Counted = true
 
 	result := &action_kit_api.StartResult{
 		Messages: &[]action_kit_api.Message{
@@ -194,61 +261,150 @@ func (l *jobRunAction) Start(ctx context.Context, state *JobRunActionState) (*ac
 	return result, nil
 }
 
-func (l *jobRunAction) Status(ctx context.Context, state *JobRunActionState) (*action_kit_api.StatusResult, error) {
-	task, err := l.jenkins.GetQueueItem(ctx, state.QueueId)
+// adoptInProgressBuild attaches the action to a queued or running build of the job with matching parameters.
+// It returns nil if no such build exists and a new one has to be queued.
+func (l *jobRunAction) adoptInProgressBuild(ctx context.Context, job *gojenkins.Job, state *JobRunActionState, parameters map[string]string) (*action_kit_api.StartResult, error) {
+	queue, err := l.jenkins.GetQueue(ctx)
 	if err != nil {
-		return nil, extension_kit.ToError("Failed to fetch task.", err)
+		return nil, toJenkinsError("Failed to fetch queue.", err)
+	}
+	for _, task := range queue.Tasks() {
+		if task.Raw.Task.URL != job.Raw.URL {
+			continue
+		}
+		values := make(map[string]string)
+		for _, parameter := range task.GetParameters() {
+			values[parameter.Name] = fmt.Sprint(parameter.Value)
+		}
+		if !parametersMatch(values, parameters, state.SecretParameters) {
+			continue
+		}
+		log.Info().Int64("queueId", task.Raw.ID).Msg("Attaching to already queued job.")
+		state.QueueId = task.Raw.ID
+		state.Adopted = true
+		return &action_kit_api.StartResult{
+			Messages: &[]action_kit_api.Message{
+				{
+					Message: "- Job is already queued, attached to it. Waiting for job to start...",
+					Type:    new("JENKINS"),
+				},
+			},
+		}, nil
+	}
+
+	// Builds are listed newest first, only the most recent ones are checked for a running build.
+	recentBuilds := job.Raw.Builds[:min(len(job.Raw.Builds), adoptableBuildsLimit)]
+	for _, jobBuild := range recentBuilds {
+		build, err := job.GetBuild(ctx, jobBuild.Number)
+		if err != nil {
+			return nil, toJenkinsError("Failed to fetch build.", err)
+		}
+		if !build.Raw.Building {
+			continue
+		}
+		values := make(map[string]string)
+		for _, parameter := range build.GetParameters() {
+			values[parameter.Name] = fmt.Sprint(parameter.Value)
+		}
+		if !parametersMatch(values, parameters, state.SecretParameters) {
+			continue
+		}
+		log.Info().Int64("runId", build.Raw.Number).Msg("Attaching to already running job.")
+		state.QueueId = build.Raw.QueueID
+		state.RunId = build.Raw.Number
+		state.Adopted = true
+		return &action_kit_api.StartResult{
+			Messages: &[]action_kit_api.Message{
+				{
+					Message: fmt.Sprintf("- Job is already running, attached to it. [Open](%s) [Console](%sconsole)", build.Raw.URL, build.Raw.URL),
+					Type:    new("JENKINS"),
+				},
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+// parametersMatch compares the parameters of a build with the expected ones. Jenkins does not reveal the values of
+// secret parameters, so these are not compared.
+func parametersMatch(actual map[string]string, expected map[string]string, secrets []string) bool {
+	for name, value := range expected {
+		if slices.Contains(secrets, name) {
+			continue
+		}
+		if actualValue, ok := actual[name]; !ok || actualValue != value {
+			return false
+		}
 	}
+	return true
+}
+
+func (l *jobRunAction) Status(ctx context.Context, state *JobRunActionState) (_ *action_kit_api.StatusResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run.status", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, fullJobName(state.JobName, state.ParentIds), "")
 
 	justStarted := false
-	if state.RunId == 0 && task.Raw.Executable.Number != 0 {
-		state.RunId = task.Raw.Executable.Number
-		justStarted = true
+	if state.RunId == 0 {
+		_, runId, err := resolveQueueItem(ctx, l.jenkins, state.JobName, state.ParentIds, state.QueueId)
+		if err != nil {
+			return nil, toJenkinsError("Failed to fetch task.", err)
+		}
+		if runId != 0 {
+			state.RunId = runId
+			justStarted = true
+		}
 	}
 
 	if state.RunId != 0 {
 		job, err := l.jenkins.GetJob(ctx, state.JobName, state.ParentIds...)
 		if err != nil {
-			return nil, extension_kit.ToError("Failed to find job.", err)
+			return nil, toJenkinsError("Failed to find job.", err)
 		}
 		build, err := job.GetBuild(ctx, state.RunId)
 		if err != nil {
-			return nil, extension_kit.ToError("Failed to fetch build.", err)
+			return nil, toJenkinsError("Failed to fetch build.", err)
 		}
 
-		if justStarted {
-			if !state.WaitForCompletion {
-				log.Info().Int64("runId", task.Raw.Executable.Number).Msg("Job started, action will not waiting for completion.")
-				state.DontStop = true
-				return &action_kit_api.StatusResult{
-					Completed: true,
-					Messages: &[]action_kit_api.Message{
-						{
-							Message: fmt.Sprintf("- Job started, action will not wait for completion. [Build](%s) [Console](%sconsole)", build.Raw.URL, build.Raw.URL),
-							Type:    new("JENKINS"),
-						},
+		if justStarted && !state.Adopted {
+			state.Correlation.annotate(ctx, build)
+		}
+
+		if !state.WaitForCompletion {
+			log.Info().Int64("runId", state.RunId).Msg("Job started, action will not waiting for completion.")
+			state.DontStop = true
+			return &action_kit_api.StatusResult{
+				Completed: true,
+				Messages: &[]action_kit_api.Message{
+					{
+						Message: fmt.Sprintf("- Job started, action will not wait for completion. [Build](%s) [Console](%sconsole)", build.Raw.URL, build.Raw.URL),
+						Type:    new("JENKINS"),
 					},
-				}, nil
-			} else {
-				log.Info().Int64("runId", task.Raw.Executable.Number).Msg("Job started.")
-				return &action_kit_api.StatusResult{
-					Completed: false,
-					Messages: &[]action_kit_api.Message{
-						{
-							Message: fmt.Sprintf("- Job started. [Open](%s) [Console](%sconsole)", build.Raw.URL, build.Raw.URL),
-							Type:    new("JENKINS"),
-						},
+				},
+			}, nil
+		}
+
+		if justStarted {
+			log.Info().Int64("runId", state.RunId).Msg("Job started.")
+			return &action_kit_api.StatusResult{
+				Completed: false,
+				Messages: &[]action_kit_api.Message{
+					{
+						Message: fmt.Sprintf("- Job started. [Open](%s) [Console](%sconsole)", build.Raw.URL, build.Raw.URL),
+						Type:    new("JENKINS"),
 					},
-				}, nil
-			}
+				},
+			}, nil
 		}
 
 		if !build.Raw.Building {
 			log.Info().Str("result", build.Raw.Result).Msg("Job completed.")
+			metricBuildResults.WithLabelValues(build.Raw.Result).Inc()
+			span.SetAttribute("jenkins.build.result", build.Raw.Result)
 			state.DontStop = true
 			var result *action_kit_api.ActionKitError = nil
 			var messages []action_kit_api.Message
-			if build.Raw.Result != gojenkins.STATUS_FIXED && build.Raw.Result != gojenkins.STATUS_SUCCESS && build.Raw.Result != gojenkins.STATUS_PASSED {
+			if !isSuccessfulResult(build.Raw.Result) {
 				result = &action_kit_api.ActionKitError{
 					Status: extutil.Ptr(action_kit_api.Failed),
 					Title:  fmt.Sprintf("Job ended with result: %s", build.Raw.Result),
@@ -287,36 +443,65 @@ func (l *jobRunAction) Status(ctx context.Context, state *JobRunActionState) (*a
 	}, nil
 }
 
-func (l *jobRunAction) Stop(ctx context.Context, state *JobRunActionState) (*action_kit_api.StopResult, error) {
+func isSuccessfulResult(result string) bool {
+	return result == gojenkins.STATUS_FIXED || result == gojenkins.STATUS_SUCCESS || result == gojenkins.STATUS_PASSED
+}
+
+func (l *jobRunAction) Stop(ctx context.Context, state *JobRunActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run.stop", state.Correlation, state.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, fullJobName(state.JobName, state.ParentIds), "")
+
+	if state.Counted {
+		metricActiveJobRuns.WithLabelValues(jobRunActionId).Dec()
+		state.Counted = false
+	}
 	if state.DontStop {
 		return nil, nil
 	}
-
-	task, err := l.jenkins.GetQueueItem(ctx, state.QueueId)
-	if err != nil {
-		return nil, extension_kit.ToError("Failed to fetch task.", err)
-	}
-	canceled, err := task.Cancel(ctx)
-	if err != nil {
-		return nil, extension_kit.ToError("Failed to cancel the task.", err)
+	if state.Adopted {
+		log.Info().Msg("Build was not triggered by this action, leaving it running.")
+		return &action_kit_api.StopResult{
+			Messages: &[]action_kit_api.Message{
+				{
+					Message: "- Attached build was not triggered by this step and is left running.",
+					Type:    new("JENKINS"),
+				},
+			},
+		}, nil
 	}
-	if canceled {
-		log.Info().Msg("Task canceled.")
+
+	runId := state.RunId
+	if runId == 0 {
+		task, resolvedRunId, err := resolveQueueItem(ctx, l.jenkins, state.JobName, state.ParentIds, state.QueueId)
+		if err != nil {
+			return nil, toJenkinsError("Failed to fetch task.", err)
+		}
+		if task != nil {
+			canceled, err := task.Cancel(ctx)
+			if err != nil {
+				return nil, toJenkinsError("Failed to cancel the task.", err)
+			}
+			if canceled {
+				log.Info().Msg("Task canceled.")
+			}
+		}
+		runId = resolvedRunId
 	}
 
 	var messages []action_kit_api.Message
-	if task.Raw.Executable.Number != 0 {
+	if runId != 0 {
 		job, err := l.jenkins.GetJob(ctx, state.JobName, state.ParentIds...)
 		if err != nil {
-			return nil, extension_kit.ToError("Failed to find job.", err)
+			return nil, toJenkinsError("Failed to find job.", err)
 		}
-		build, err := job.GetBuild(ctx, task.Raw.Executable.Number)
+		build, err := job.GetBuild(ctx, runId)
 		if err != nil {
-			return nil, extension_kit.ToError("Failed to fetch build.", err)
+			return nil, toJenkinsError("Failed to fetch build.", err)
 		}
 		stopped, err := build.Stop(ctx)
 		if err != nil {
-			return nil, extension_kit.ToError("Failed to stop build.", err)
+			return nil, toJenkinsError("Failed to stop build.", err)
 		}
 		if stopped {
 			log.Info().Msg("Job stopped.")
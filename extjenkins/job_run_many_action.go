@@ -0,0 +1,443 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const (
+	jobRunManyActionId = TargetTypeJob + ".run-many"
+	// jobRunManyConcurrency limits the requests sent concurrently to Jenkins by all executions of the action.
+	jobRunManyConcurrency = 10
+)
+
+var jobRunManySlots = make(chan struct{}, jobRunManyConcurrency)
+
+type jobRunManyAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[JobRunManyActionState]           = (*jobRunManyAction)(nil)
+	_ action_kit_sdk.ActionWithStatus[JobRunManyActionState] = (*jobRunManyAction)(nil)
+	_ action_kit_sdk.ActionWithStop[JobRunManyActionState]   = (*jobRunManyAction)(nil)
+)
+
+// JobRunManyActionState is the state of the execution for a single selected job. The platform runs the action once per
+// selected job, every execution reports the run of its own job.
+type JobRunManyActionState struct {
+	Run               JobRunState
+	WaitForCompletion bool
+	Parameters        map[string]string
+	SecretParameters  []string
+	Correlation       BuildCorrelation
+	Counted           bool
+	DontStop          bool
+	TimeoutOffset     time.Duration `json:"timeoutOffset"`
+}
+
+// JobRunState tracks the queue item and build of a single job triggered by the jobRunManyAction.
+type JobRunState struct {
+	JobName   string
+	ParentIds []string
+	FullName  string
+	QueueId   int64
+	RunId     int64
+	Url       string
+	Result    string
+	// Error is set if the job could not be queued, did not start in time or its build could not be found.
+	Error string
+	// LastError is the last transient failure, the run is polled again with the next status check.
+	LastError string
+}
+
+func NewJobRunManyAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[JobRunManyActionState] {
+	return &jobRunManyAction{jenkins: jenkins}
+}
+
+func (l *jobRunManyAction) NewEmptyState() JobRunManyActionState {
+	return JobRunManyActionState{}
+}
+
+func (l *jobRunManyAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          jobRunManyActionId,
+		Label:       "Run Jenkins Jobs",
+		Description: "Starts all selected Jenkins jobs concurrently and reports the result of each.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconJob),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeJob,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "job name",
+					Query: "jenkins.job.name=\"\"",
+				},
+				{
+					Label: "jobs in view",
+					Query: "jenkins.job.view=\"\"",
+				},
+			}),
+			QuantityRestriction: extutil.Ptr(action_kit_api.QuantityRestrictionAll),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Other,
+		TimeControl: action_kit_api.TimeControlInternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Estimated Duration",
+				Description:  new("If `Wait for Completion` is checked, the step will run as long as needed. You can set this estimation to size the step in the experiment editor for a better understanding of the time schedule."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "waitForCompletion",
+				Label:        "Wait for Completion",
+				Description:  new("If enabled, the action will wait for all jobs to complete before returning. If disabled, the action will return as soon as all jobs have started."),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("true"),
+				Required:     new(true),
+			},
+			{
+				Name:        "parameters",
+				Label:       "Parameters",
+				Description: new("Optional parameters to pass to every job. Jobs not defining a parameter will ignore it. Use `${secret:NAME}` as value to pass a secret provided to the extension, the value then never appears in the experiment."),
+				Type:        action_kit_api.ActionParameterTypeKeyValue,
+				Required:    new(false),
+			},
+		},
+		Status: new(action_kit_api.MutatingEndpointReferenceWithCallInterval{
+			CallInterval: new("2s"),
+		}),
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (l *jobRunManyAction) Prepare(ctx context.Context, state *JobRunManyActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startJobSpan(ctx, "jenkins.job.run-many.prepare", newBuildCorrelation(request.ExecutionContext), request.Target.Name)
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	fullName := extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name.full")[0]
+	if err = checkJobsAllowed(fullName); err != nil {
+		return nil, err
+	}
+
+	state.Run = JobRunState{
+		JobName:   extutil.MustHaveValue(request.Target.Attributes, "jenkins.job.name")[0],
+		ParentIds: extractParentIds(fullName),
+		FullName:  fullName,
+	}
+	state.WaitForCompletion = extutil.ToBool(request.Config["waitForCompletion"])
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	jobStartTimeout := time.Duration(int(time.Second) * config.Config.JobStartTimeoutSeconds)
+	state.TimeoutOffset = time.Since(referenceTime) + jobStartTimeout
+	if (request.Config["parameters"]) != nil {
+		state.Parameters, err = extutil.ToKeyValue(request.Config, "parameters")
+		if err != nil {
+			return nil, err
+		}
+		if err = checkParametersAllowed(state.Parameters); err != nil {
+			return nil, err
+		}
+		state.SecretParameters, err = checkSecretParameters(state.Parameters, request.Target.Attributes["jenkins.job.parameter.secret"])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (l *jobRunManyAction) Start(ctx context.Context, state *JobRunManyActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run-many.start", state.Correlation, state.Run.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, state.Run.FullName, "")
+	ctx = withSecretParameters(ctx, state.SecretParameters)
+
+	log.Info().Str("job", state.Run.FullName).
+		Interface("parameters", redactParameters(state.Parameters, state.SecretParameters)).
+		Msg("Starting job.")
+
+	parameters, err := resolveSecretReferences(state.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &state.Run
+	release, err := acquireJobRunSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	message := l.queueRun(ctx, run, state.Correlation, parameters)
+	release()
+	if run.QueueId != 0 {
+		metricActiveJobRuns.WithLabelValues(jobRunManyActionId).Inc()
+		state.Counted = true
+	}
+
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: message,
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+// queueRun triggers the job. A job which cannot be queued is recorded as failed run rather than failing the step, so
+// it is reported like the other runs of the step.
+func (l *jobRunManyAction) queueRun(ctx context.Context, run *JobRunState, correlation BuildCorrelation, parameters map[string]string) string {
+	job, err := l.jenkins.GetJob(ctx, run.JobName, run.ParentIds...)
+	if err != nil {
+		return run.abort("Failed to find job.", err)
+	}
+	queueId, err := job.InvokeSimple(ctx, correlation.withParameters(job, parameters))
+	if err != nil {
+		return run.abort("Failed to queue job.", err)
+	}
+	if queueId == 0 {
+		return run.abort("Job is already queued.", nil)
+	}
+	log.Info().Str("job", run.FullName).Int64("queueId", queueId).Msg("Job queued successfully.")
+	run.QueueId = queueId
+	return fmt.Sprintf("- `%s` queued, waiting for it to start...", run.FullName)
+}
+
+func (l *jobRunManyAction) Status(ctx context.Context, state *JobRunManyActionState) (_ *action_kit_api.StatusResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run-many.status", state.Correlation, state.Run.JobName)
+	defer span.EndWithError(&err)
+
+	run := &state.Run
+	var messages []action_kit_api.Message
+	if !run.done(state.WaitForCompletion) {
+		release, err := acquireJobRunSlot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		timedOut := time.Since(referenceTime) > state.TimeoutOffset
+		message := l.updateRun(ctx, run, state.Correlation, timedOut)
+		release()
+		if message != "" {
+			messages = append(messages, action_kit_api.Message{
+				Message: message,
+				Type:    new("JENKINS"),
+			})
+		}
+	}
+
+	if !run.done(state.WaitForCompletion) {
+		return &action_kit_api.StatusResult{
+			Completed: false,
+			Messages:  &messages,
+		}, nil
+	}
+	state.DontStop = run.Error == ""
+	return &action_kit_api.StatusResult{
+		Completed: true,
+		Error:     run.actionError(state.WaitForCompletion),
+		Messages:  &messages,
+	}, nil
+}
+
+// updateRun polls the queue item and build of a single job and returns a widget message if the run changed its phase.
+func (l *jobRunManyAction) updateRun(ctx context.Context, run *JobRunState, correlation BuildCorrelation, timedOut bool) string {
+	ctx = withAuditSubject(ctx, correlation, run.FullName, "")
+	justStarted := false
+	if run.RunId == 0 {
+		_, runId, err := resolveQueueItem(ctx, l.jenkins, run.JobName, run.ParentIds, run.QueueId)
+		if err != nil {
+			return run.fail("Failed to fetch task.", err)
+		}
+		if runId == 0 {
+			run.LastError = ""
+			if timedOut {
+				return run.abort("Timed out waiting for job to start.", nil)
+			}
+			return ""
+		}
+		run.RunId = runId
+		justStarted = true
+		log.Info().Str("job", run.FullName).Int64("runId", run.RunId).Msg("Job started.")
+	}
+
+	job, err := l.jenkins.GetJob(ctx, run.JobName, run.ParentIds...)
+	if err != nil {
+		return run.fail("Failed to find job.", err)
+	}
+	build, err := job.GetBuild(ctx, run.RunId)
+	if err != nil {
+		return run.fail("Failed to fetch build.", err)
+	}
+	run.LastError = ""
+	run.Url = build.Raw.URL
+	if justStarted {
+		correlation.annotate(ctx, build)
+	}
+
+	if build.Raw.Building {
+		if justStarted {
+			return fmt.Sprintf("- `%s` started. [Open](%s) [Console](%sconsole)", run.FullName, run.Url, run.Url)
+		}
+		return ""
+	}
+	log.Info().Str("job", run.FullName).Str("result", build.Raw.Result).Msg("Job completed.")
+	run.Result = build.Raw.Result
+	metricBuildResults.WithLabelValues(build.Raw.Result).Inc()
+	if run.succeeded() {
+		return fmt.Sprintf("- `%s` ended with result '%s' ✅", run.FullName, run.Result)
+	}
+	return fmt.Sprintf("- `%s` ended with result '%s' ⚠️", run.FullName, run.Result)
+}
+
+func (l *jobRunManyAction) Stop(ctx context.Context, state *JobRunManyActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startJobSpan(ctx, "jenkins.job.run-many.stop", state.Correlation, state.Run.JobName)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, state.Run.FullName, "")
+
+	if state.Counted {
+		metricActiveJobRuns.WithLabelValues(jobRunManyActionId).Dec()
+		state.Counted = false
+	}
+	run := &state.Run
+	if state.DontStop || run.Result != "" || (run.QueueId == 0 && run.RunId == 0) {
+		return nil, nil
+	}
+
+	release, err := acquireJobRunSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	stopped, err := l.stopRun(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if !stopped {
+		return nil, nil
+	}
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- `%s` stopped. 🛑", run.FullName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (l *jobRunManyAction) stopRun(ctx context.Context, run *JobRunState) (bool, error) {
+	runId := run.RunId
+	if runId == 0 {
+		task, resolvedRunId, err := resolveQueueItem(ctx, l.jenkins, run.JobName, run.ParentIds, run.QueueId)
+		if err != nil {
+			return false, toJenkinsError("Failed to fetch task.", err)
+		}
+		if task != nil {
+			if _, err = task.Cancel(ctx); err != nil {
+				return false, toJenkinsError("Failed to cancel the task.", err)
+			}
+		}
+		if resolvedRunId == 0 {
+			log.Info().Str("job", run.FullName).Msg("Task canceled.")
+			return true, nil
+		}
+		runId = resolvedRunId
+	}
+	job, err := l.jenkins.GetJob(ctx, run.JobName, run.ParentIds...)
+	if err != nil {
+		return false, toJenkinsError("Failed to find job.", err)
+	}
+	build, err := job.GetBuild(ctx, runId)
+	if err != nil {
+		return false, toJenkinsError("Failed to fetch build.", err)
+	}
+	stopped, err := build.Stop(ctx)
+	if err != nil {
+		return false, toJenkinsError("Failed to stop the build.", err)
+	}
+	if stopped {
+		log.Info().Str("job", run.FullName).Msg("Job stopped.")
+	}
+	return stopped, nil
+}
+
+// acquireJobRunSlot blocks until fewer than jobRunManyConcurrency executions talk to Jenkins and returns the function
+// releasing the slot again.
+func acquireJobRunSlot(ctx context.Context) (func(), error) {
+	select {
+	case jobRunManySlots <- struct{}{}:
+		return func() { <-jobRunManySlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fail records a failed request. Transient failures are retried with the next status check, others end the run.
+func (r *JobRunState) fail(title string, err error) string {
+	if !isTransient(err) {
+		return r.abort(title, err)
+	}
+	log.Debug().Err(err).Str("job", r.FullName).Msg(title)
+	r.LastError = toJenkinsError(title, err).Error()
+	return ""
+}
+
+// abort ends the run with an error.
+func (r *JobRunState) abort(title string, err error) string {
+	log.Warn().Err(err).Str("job", r.FullName).Msg(title)
+	r.Error = toJenkinsError(title, err).Error()
+	return fmt.Sprintf("- `%s`: %s ⚠️", r.FullName, r.Error)
+}
+
+func (r *JobRunState) succeeded() bool {
+	return r.Error == "" && isSuccessfulResult(r.Result)
+}
+
+// done reports whether nothing is left to wait for: the run failed, ended, or started without waiting for completion.
+func (r *JobRunState) done(waitForCompletion bool) bool {
+	return r.Error != "" || r.Result != "" || (!waitForCompletion && r.RunId != 0)
+}
+
+// actionError fails the execution if the job could not be started or, when waiting for completion, did not succeed.
+func (r *JobRunState) actionError(waitForCompletion bool) *action_kit_api.ActionKitError {
+	if r.Error != "" {
+		return &action_kit_api.ActionKitError{
+			Status: extutil.Ptr(action_kit_api.Errored),
+			Title:  fmt.Sprintf("Job %s could not be started: %s", r.FullName, r.Error),
+		}
+	}
+	if waitForCompletion && !r.succeeded() {
+		return &action_kit_api.ActionKitError{
+			Status: extutil.Ptr(action_kit_api.Failed),
+			Title:  fmt.Sprintf("Job %s ended with result '%s'.", r.FullName, r.Result),
+		}
+	}
+	return nil
+}
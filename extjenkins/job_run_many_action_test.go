@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"testing"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRunStateDone(t *testing.T) {
+	tests := []struct {
+		name              string
+		run               JobRunState
+		waitForCompletion bool
+		want              bool
+	}{
+		{name: "queued", run: JobRunState{QueueId: 1}, waitForCompletion: true, want: false},
+		{name: "running", run: JobRunState{QueueId: 1, RunId: 2}, waitForCompletion: true, want: false},
+		{name: "running without waiting", run: JobRunState{QueueId: 1, RunId: 2}, waitForCompletion: false, want: true},
+		{name: "transient failure", run: JobRunState{QueueId: 1, LastError: "502"}, waitForCompletion: true, want: false},
+		{name: "failed", run: JobRunState{Error: "not found"}, waitForCompletion: true, want: true},
+		{name: "ended", run: JobRunState{QueueId: 1, RunId: 2, Result: "FAILURE"}, waitForCompletion: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.run.done(tt.waitForCompletion))
+		})
+	}
+}
+
+func TestJobRunStateActionError(t *testing.T) {
+	tests := []struct {
+		name              string
+		run               JobRunState
+		waitForCompletion bool
+		wantStatus        *action_kit_api.ActionKitErrorStatus
+	}{
+		{name: "succeeded", run: JobRunState{FullName: "a", QueueId: 1, RunId: 1, Result: "SUCCESS"}, waitForCompletion: true},
+		{name: "failed", run: JobRunState{FullName: "a", QueueId: 1, RunId: 1, Result: "FAILURE"}, waitForCompletion: true, wantStatus: new(action_kit_api.Failed)},
+		{name: "not queued", run: JobRunState{FullName: "a", Error: "Job is already queued."}, waitForCompletion: true, wantStatus: new(action_kit_api.Errored)},
+		{name: "not waiting ignores result", run: JobRunState{FullName: "a", QueueId: 1, RunId: 1}, waitForCompletion: false},
+		{name: "not waiting reports start failure", run: JobRunState{FullName: "a", Error: "Job is already queued."}, waitForCompletion: false, wantStatus: new(action_kit_api.Errored)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run.actionError(tt.waitForCompletion)
+			if tt.wantStatus == nil {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, *tt.wantStatus, *err.Status)
+		})
+	}
+}
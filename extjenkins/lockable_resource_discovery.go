@@ -0,0 +1,173 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+// lockableResource is a resource of the Lockable Resources plugin, as listed by /lockable-resources/api/json.
+type lockableResource struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Labels are separated by whitespace.
+	Labels     string `json:"labels"`
+	Locked     bool   `json:"locked"`
+	Reserved   bool   `json:"reserved"`
+	ReservedBy string `json:"reservedBy"`
+	BuildName  string `json:"buildName"`
+	Ephemeral  bool   `json:"ephemeral"`
+}
+
+type lockableResources struct {
+	Resources []lockableResource `json:"resources"`
+}
+
+// getLockableResources lists the resources of the Lockable Resources plugin. If the plugin is not installed, no
+// resources are returned.
+func getLockableResources(ctx context.Context, jenkins *gojenkins.Jenkins) ([]lockableResource, error) {
+	var response lockableResources
+	if _, err := jenkins.Requester.GetJSON(ctx, "/lockable-resources", &response, nil); err != nil {
+		if isNotFound(err) {
+			log.Debug().Msg("Lockable Resources plugin not installed.")
+			return nil, nil
+		}
+		return nil, err
+	}
+	return response.Resources, nil
+}
+
+type lockableResourceDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*lockableResourceDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*lockableResourceDiscovery)(nil)
+)
+
+func NewLockableResourceDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &lockableResourceDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 5*time.Minute),
+	)
+}
+
+func (d *lockableResourceDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeLockableResource,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *lockableResourceDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeLockableResource,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconLockableResource),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Lockable Resource", Other: "Jenkins Lockable Resources"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.lockable-resource.name"},
+				{Attribute: "jenkins.lockable-resource.label"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.lockable-resource.name",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *lockableResourceDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.lockable-resource.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Lockable resource name",
+				Other: "Lockable resource names",
+			},
+		},
+		{
+			Attribute: "jenkins.lockable-resource.description",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Lockable resource description",
+				Other: "Lockable resource descriptions",
+			},
+		},
+		{
+			Attribute: "jenkins.lockable-resource.label",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Lockable resource label",
+				Other: "Lockable resource labels",
+			},
+		},
+	}
+}
+
+// lockableResourceAttributes lists all attributes of lockable resource targets, which may be excluded through
+// DiscoveryAttributesExcludesLockableResource.
+var lockableResourceAttributes = []string{
+	"jenkins.lockable-resource.name",
+	"jenkins.lockable-resource.description",
+	"jenkins.lockable-resource.label",
+}
+
+func (d *lockableResourceDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.lockable-resources", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	resources, err := getLockableResources(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeLockableResource, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch lockable resources.", err)
+	}
+
+	targets := make([]discovery_kit_api.Target, 0, len(resources))
+	for _, resource := range resources {
+		// Ephemeral resources only exist while they are locked by a build.
+		if resource.Ephemeral {
+			continue
+		}
+		target := discovery_kit_api.Target{
+			Id:         resource.Name,
+			TargetType: TargetTypeLockableResource,
+			Label:      resource.Name,
+			Attributes: map[string][]string{
+				"jenkins.lockable-resource.name": {resource.Name},
+			},
+		}
+		if resource.Description != "" {
+			target.Attributes["jenkins.lockable-resource.description"] = []string{resource.Description}
+		}
+		if labels := strings.Fields(resource.Labels); len(labels) > 0 {
+			target.Attributes["jenkins.lockable-resource.label"] = labels
+		}
+		targets = append(targets, target)
+	}
+	observeDiscovery(TargetTypeLockableResource, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesLockableResource), nil
+}
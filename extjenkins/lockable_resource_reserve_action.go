@@ -0,0 +1,170 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const lockableResourceReserveActionId = TargetTypeLockableResource + ".reserve"
+
+type lockableResourceReserveAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[LockableResourceReserveActionState]         = (*lockableResourceReserveAction)(nil)
+	_ action_kit_sdk.ActionWithStop[LockableResourceReserveActionState] = (*lockableResourceReserveAction)(nil)
+)
+
+type LockableResourceReserveActionState struct {
+	ResourceName string
+	// Reserved is set once the resource was reserved by this action, only then it is released on stop.
+	Reserved    bool
+	Correlation BuildCorrelation
+}
+
+func NewLockableResourceReserveAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[LockableResourceReserveActionState] {
+	return &lockableResourceReserveAction{jenkins: jenkins}
+}
+
+func (a *lockableResourceReserveAction) NewEmptyState() LockableResourceReserveActionState {
+	return LockableResourceReserveActionState{}
+}
+
+func (a *lockableResourceReserveAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          lockableResourceReserveActionId,
+		Label:       "Reserve Lockable Resource",
+		Description: "Reserves a lockable resource for the given duration, so builds waiting for it are blocked. The resource is released when the step ends.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconLockableResource),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeLockableResource,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "resource name",
+					Query: "jenkins.lockable-resource.name=\"\"",
+				},
+				{
+					Label: "resource label",
+					Query: "jenkins.lockable-resource.label=\"\"",
+				},
+			}),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long the resource is reserved."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *lockableResourceReserveAction) Prepare(ctx context.Context, state *LockableResourceReserveActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startActionSpan(ctx, "jenkins.lockable-resource.reserve.prepare", newBuildCorrelation(request.ExecutionContext), map[string]any{"jenkins.lockable-resource.name": request.Target.Name})
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	if err = checkPluginsInstalled(ctx, a.jenkins, "lockable-resources"); err != nil {
+		return nil, err
+	}
+	state.ResourceName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.lockable-resource.name")[0]
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *lockableResourceReserveAction) Start(ctx context.Context, state *LockableResourceReserveActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.lockable-resource.reserve.start", state.Correlation, map[string]any{"jenkins.lockable-resource.name": state.ResourceName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	resources, err := getLockableResources(ctx, a.jenkins)
+	if err != nil {
+		return nil, toJenkinsError("Failed to fetch lockable resources.", err)
+	}
+	var resource *lockableResource
+	for i := range resources {
+		if resources[i].Name == state.ResourceName {
+			resource = &resources[i]
+		}
+	}
+	switch {
+	case resource == nil:
+		return nil, extension_kit.ToError(fmt.Sprintf("Lockable resource '%s' not found in Jenkins.", state.ResourceName), nil)
+	case resource.Reserved:
+		return nil, extension_kit.ToError(fmt.Sprintf("Lockable resource '%s' is already reserved by %s.", state.ResourceName, resource.ReservedBy), nil)
+	case resource.Locked:
+		return nil, extension_kit.ToError(fmt.Sprintf("Lockable resource '%s' is locked by %s.", state.ResourceName, resource.BuildName), nil)
+	}
+
+	log.Info().Str("resource", state.ResourceName).Msg("Reserving lockable resource.")
+	if _, err = postForm(ctx, a.jenkins, "/lockable-resources/reserve", url.Values{}, map[string]string{"resource": state.ResourceName}); err != nil {
+		return nil, toJenkinsError("Failed to reserve lockable resource.", err)
+	}
+	state.Reserved = true
+
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Lockable resource '%s' reserved. 🔒", state.ResourceName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *lockableResourceReserveAction) Stop(ctx context.Context, state *LockableResourceReserveActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.lockable-resource.reserve.stop", state.Correlation, map[string]any{"jenkins.lockable-resource.name": state.ResourceName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	if !state.Reserved {
+		return nil, nil
+	}
+	log.Info().Str("resource", state.ResourceName).Msg("Releasing lockable resource.")
+	if _, err = postForm(ctx, a.jenkins, "/lockable-resources/unreserve", url.Values{}, map[string]string{"resource": state.ResourceName}); err != nil {
+		return nil, toJenkinsError("Failed to release lockable resource.", err)
+	}
+	state.Reserved = false
+
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Lockable resource '%s' released. 🔓", state.ResourceName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
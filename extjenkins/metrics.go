@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/steadybit/extension-jenkins/config"
+)
+
+var (
+	metricDiscoveryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "steadybit_extension_jenkins_discovery_duration_seconds",
+		Help:    "Duration of target discoveries.",
+		Buckets: latencyBuckets,
+	}, []string{"target_type"})
+	metricDiscoveredTargets = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "steadybit_extension_jenkins_discovered_targets",
+		Help: "Number of targets found by the last discovery.",
+	}, []string{"target_type"})
+	metricDiscoveryErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "steadybit_extension_jenkins_discovery_errors_total",
+		Help: "Number of failed target discoveries.",
+	}, []string{"target_type"})
+	metricApiRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "steadybit_extension_jenkins_api_requests_total",
+		Help: "Number of requests sent to Jenkins. The status is 'error' if no response was received.",
+	}, []string{"method", "endpoint", "status"})
+	metricApiRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "steadybit_extension_jenkins_api_request_duration_seconds",
+		Help:    "Latency of requests sent to Jenkins.",
+		Buckets: latencyBuckets,
+	}, []string{"method", "endpoint"})
+	metricActiveJobRuns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "steadybit_extension_jenkins_active_job_runs",
+		Help: "Number of running actions triggering Jenkins jobs.",
+	}, []string{"action"})
+	metricBuildResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "steadybit_extension_jenkins_build_results_total",
+		Help: "Number of completed builds triggered or watched by actions, by result.",
+	}, []string{"result"})
+)
+
+// latencyBuckets are the upper bounds in seconds of latency histograms. Discoveries and slow Jenkins requests take
+// longer than the default buckets of Prometheus cover.
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var (
+	numericSegment = regexp.MustCompile(`^\d+$`)
+	// Path segments followed by the name of a job, view, node or user.
+	namedSegments = []string{"job", "view", "computer", "user"}
+)
+
+// observeDiscovery records duration and outcome of a discovery run.
+func observeDiscovery(targetType string, start time.Time, targets int, err error) {
+	metricDiscoveryDuration.WithLabelValues(targetType).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metricDiscoveryErrors.WithLabelValues(targetType).Inc()
+		return
+	}
+	metricDiscoveredTargets.WithLabelValues(targetType).Set(float64(targets))
+}
+
+// metricsTransport records count and latency of all requests sent to Jenkins.
+type metricsTransport struct {
+	delegate http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := t.delegate.RoundTrip(request)
+	endpoint := normalizeEndpoint(request.URL.Path)
+	status := "error"
+	if response != nil {
+		status = strconv.Itoa(response.StatusCode)
+	}
+	metricApiRequests.WithLabelValues(request.Method, endpoint, status).Inc()
+	metricApiRequestDuration.WithLabelValues(request.Method, endpoint).Observe(time.Since(start).Seconds())
+	return response, err
+}
+
+func (t *metricsTransport) CloseIdleConnections() {
+	if closer, ok := t.delegate.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// normalizeEndpoint replaces names of jobs, views and nodes as well as build and queue ids in the path by
+// placeholders, to keep the number of endpoint label values bounded.
+func normalizeEndpoint(path string) string {
+	path = strings.TrimPrefix(path, basePath())
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case i > 0 && slices.Contains(namedSegments, segments[i-1]) && segment != "":
+			segments[i] = ":name"
+		case numericSegment.MatchString(segment):
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func basePath() string {
+	baseUrl, err := url.Parse(config.Config.BaseUrl)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(baseUrl.Path, "/")
+}
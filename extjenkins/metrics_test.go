@@ -0,0 +1,107 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseUrl string
+		path    string
+		want    string
+	}{
+		{name: "root", baseUrl: "https://jenkins", path: "/api/json", want: "/api/json"},
+		{name: "job", baseUrl: "https://jenkins", path: "/job/deploy/api/json", want: "/job/:name/api/json"},
+		{name: "nested job and build", baseUrl: "https://jenkins", path: "/job/team/job/deploy/42/api/json", want: "/job/:name/job/:name/:id/api/json"},
+		{name: "queue item", baseUrl: "https://jenkins", path: "/queue/item/4711/api/json", want: "/queue/item/:id/api/json"},
+		{name: "node", baseUrl: "https://jenkins", path: "/computer/agent-1/config.xml", want: "/computer/:name/config.xml"},
+		{name: "view and user", baseUrl: "https://jenkins", path: "/view/Payments/user/alice/", want: "/view/:name/user/:name/"},
+		{name: "base path", baseUrl: "https://example.com/jenkins/", path: "/jenkins/job/deploy/build", want: "/job/:name/build"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Config.BaseUrl = tt.baseUrl
+			t.Cleanup(func() { config.Config.BaseUrl = "" })
+
+			assert.Equal(t, tt.want, normalizeEndpoint(tt.path))
+		})
+	}
+}
+
+type stubRoundTripper struct {
+	status int
+}
+
+func (s stubRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	if s.status == 0 {
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{StatusCode: s.status, Body: http.NoBody}, nil
+}
+
+func TestMetricsTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus string
+	}{
+		{name: "success", status: http.StatusOK, wantStatus: "200"},
+		{name: "error status", status: http.StatusBadGateway, wantStatus: "502"},
+		{name: "no response", wantStatus: "error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := metricApiRequests.WithLabelValues(http.MethodGet, "/job/:name/api/json", tt.wantStatus)
+			before := testutil.ToFloat64(counter)
+			transport := &metricsTransport{delegate: stubRoundTripper{status: tt.status}}
+
+			request := httptest.NewRequest(http.MethodGet, "http://jenkins/job/deploy/api/json", nil)
+			response, _ := transport.RoundTrip(request)
+			if response != nil {
+				_ = response.Body.Close()
+			}
+
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+		})
+	}
+}
+
+func TestObserveDiscovery(t *testing.T) {
+	observeDiscovery("test-type", time.Now(), 3, nil)
+	observeDiscovery("test-type", time.Now(), 0, errors.New("failed"))
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(metricDiscoveredTargets.WithLabelValues("test-type")), "failed discoveries keep the last count")
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricDiscoveryErrors.WithLabelValues("test-type")))
+}
+
+func TestMetricsExposition(t *testing.T) {
+	metricBuildResults.WithLabelValues("FAILURE").Inc()
+	metricActiveJobRuns.WithLabelValues("test-action").Inc()
+	metricActiveJobRuns.WithLabelValues("test-action").Dec()
+
+	recorder := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "# TYPE steadybit_extension_jenkins_build_results_total counter")
+	assert.Contains(t, string(body), `steadybit_extension_jenkins_build_results_total{result="FAILURE"}`)
+	assert.Contains(t, string(body), `steadybit_extension_jenkins_active_job_runs{action="test-action"} 0`)
+}
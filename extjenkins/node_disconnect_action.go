@@ -0,0 +1,210 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const nodeDisconnectActionId = TargetTypeNode + ".disconnect"
+
+type nodeDisconnectAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[NodeDisconnectActionState]         = (*nodeDisconnectAction)(nil)
+	_ action_kit_sdk.ActionWithStop[NodeDisconnectActionState] = (*nodeDisconnectAction)(nil)
+)
+
+type NodeDisconnectActionState struct {
+	NodeName     string
+	Relaunch     bool
+	Disconnected bool
+	Correlation  BuildCorrelation
+}
+
+// agentComputer is the state of an agent relevant to disconnect it.
+type agentComputer struct {
+	Offline             bool       `json:"offline"`
+	LaunchSupported     bool       `json:"launchSupported"`
+	ManualLaunchAllowed bool       `json:"manualLaunchAllowed"`
+	Executors           []executor `json:"executors"`
+	OneOffExecutors     []executor `json:"oneOffExecutors"`
+}
+
+func NewNodeDisconnectAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[NodeDisconnectActionState] {
+	return &nodeDisconnectAction{jenkins: jenkins}
+}
+
+func (a *nodeDisconnectAction) NewEmptyState() NodeDisconnectActionState {
+	return NodeDisconnectActionState{}
+}
+
+func (a *nodeDisconnectAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          nodeDisconnectActionId,
+		Label:       "Disconnect Agent",
+		Description: "Severs the channel to an agent to simulate a crash. Builds running on the agent fail. Only agents the controller can launch again are supported.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconNode),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeNode,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "node name",
+					Query: "jenkins.node.name=\"\"",
+				},
+				{
+					Label: "node label",
+					Query: "jenkins.node.label=\"\"",
+				},
+			}),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long the agent stays disconnected, if it is relaunched."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "relaunch",
+				Label:        "Relaunch Agent",
+				Description:  new("If enabled, the agent is launched again when the step ends."),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("true"),
+				Required:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *nodeDisconnectAction) Prepare(ctx context.Context, state *NodeDisconnectActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.disconnect.prepare", newBuildCorrelation(request.ExecutionContext), map[string]any{"jenkins.node.name": request.Target.Name})
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	state.NodeName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.node.name")[0]
+	state.Relaunch = extutil.ToBool(request.Config["relaunch"])
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+
+	if state.NodeName == builtInNodeName {
+		return nil, extension_kit.ToError("The built-in node cannot be disconnected.", nil)
+	}
+	// Agents provisioned by clouds are usually removed when disconnected and cannot be launched again.
+	if cloudName, ok := request.Target.Attributes["jenkins.cloud.name"]; ok {
+		return nil, extension_kit.ToError(fmt.Sprintf("Node '%s' was provisioned by cloud '%s' and cannot be launched again.", state.NodeName, cloudName[0]), nil)
+	}
+	computer, err := a.getAgent(ctx, state.NodeName)
+	if err != nil {
+		return nil, toJenkinsError("Failed to fetch node.", err)
+	}
+	if !computer.LaunchSupported || !computer.ManualLaunchAllowed {
+		return nil, extension_kit.ToError(fmt.Sprintf("Node '%s' cannot be launched by the controller, e.g. because it is an inbound agent. Only agents which can be launched again may be disconnected.", state.NodeName), nil)
+	}
+	return nil, nil
+}
+
+func (a *nodeDisconnectAction) Start(ctx context.Context, state *NodeDisconnectActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.disconnect.start", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	computer, err := a.getAgent(ctx, state.NodeName)
+	if err != nil {
+		return nil, toJenkinsError("Failed to fetch node.", err)
+	}
+	if computer.Offline {
+		return nil, extension_kit.ToError(fmt.Sprintf("Agent '%s' is already offline.", state.NodeName), nil)
+	}
+	var affected []string
+	for _, executor := range append(computer.Executors, computer.OneOffExecutors...) {
+		if executor.CurrentExecutable != nil && executor.CurrentExecutable.Url != "" {
+			affected = append(affected, fmt.Sprintf("[%s](%s)", executor.CurrentExecutable.FullDisplayName, executor.CurrentExecutable.Url))
+		}
+	}
+
+	log.Info().Str("node", state.NodeName).Int("affectedBuilds", len(affected)).Msg("Disconnecting agent.")
+	data := url.Values{"offlineMessage": {fmt.Sprintf("Disconnected by %s", state.Correlation.Label())}}
+	if _, err = postForm(ctx, a.jenkins, computerEndpoint(state.NodeName)+"/doDisconnect", data, nil); err != nil {
+		return nil, toJenkinsError("Failed to disconnect agent.", err)
+	}
+	state.Disconnected = true
+
+	message := fmt.Sprintf("- Agent '%s' disconnected, no builds were running on it. 🔌", state.NodeName)
+	if len(affected) > 0 {
+		message = fmt.Sprintf("- Agent '%s' disconnected. Affected builds: %s 🔌", state.NodeName, strings.Join(affected, ", "))
+	}
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: message,
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *nodeDisconnectAction) Stop(ctx context.Context, state *NodeDisconnectActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.disconnect.stop", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	if !state.Disconnected || !state.Relaunch {
+		return nil, nil
+	}
+	log.Info().Str("node", state.NodeName).Msg("Relaunching agent.")
+	if _, err = postForm(ctx, a.jenkins, computerEndpoint(state.NodeName)+"/launchSlaveAgent", url.Values{}, nil); err != nil {
+		return nil, toJenkinsError("Failed to relaunch agent.", err)
+	}
+	state.Disconnected = false
+
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Agent '%s' relaunched.", state.NodeName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *nodeDisconnectAction) getAgent(ctx context.Context, name string) (*agentComputer, error) {
+	var computer agentComputer
+	query := map[string]string{"tree": "offline,launchSupported,manualLaunchAllowed,executors[" + executableTree + "],oneOffExecutors[" + executableTree + "]"}
+	if _, err := a.jenkins.Requester.GetJSON(ctx, computerEndpoint(name), &computer, query); err != nil {
+		return nil, err
+	}
+	return &computer, nil
+}
@@ -0,0 +1,306 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+const (
+	builtInComputerClass = "hudson.model.Hudson$MasterComputer"
+	// builtInNodeName addresses the built-in node in the url of nodes, its display name differs.
+	builtInNodeName = "(built-in)"
+)
+
+// computer is a node as listed by /computer/api/json.
+type computer struct {
+	Class          string `json:"_class"`
+	DisplayName    string `json:"displayName"`
+	NumExecutors   int    `json:"numExecutors"`
+	Offline        bool   `json:"offline"`
+	AssignedLabels []struct {
+		Name string `json:"name"`
+	} `json:"assignedLabels"`
+}
+
+type computers struct {
+	Computer []computer `json:"computer"`
+}
+
+// getComputers lists all nodes of Jenkins, including the built-in node.
+func getComputers(ctx context.Context, jenkins *gojenkins.Jenkins) ([]computer, error) {
+	var response computers
+	query := map[string]string{"tree": "computer[_class,displayName,numExecutors,offline,assignedLabels[name]]"}
+	if _, err := jenkins.Requester.GetJSON(ctx, "/computer", &response, query); err != nil {
+		return nil, err
+	}
+	return response.Computer, nil
+}
+
+// computerEndpoint returns the endpoint of the node with the given name. The built-in node is named '(built-in)'.
+func computerEndpoint(name string) string {
+	return "/computer/" + url.PathEscape(name)
+}
+
+// getNodeConfig returns the config.xml of an agent. The built-in node has no config.xml of its own.
+func getNodeConfig(ctx context.Context, jenkins *gojenkins.Jenkins, name string) (string, error) {
+	var config string
+	_, err := jenkins.Requester.GetXML(ctx, computerEndpoint(name)+"/config.xml", &config, nil)
+	return config, err
+}
+
+func updateNodeConfig(ctx context.Context, jenkins *gojenkins.Jenkins, name string, config string) error {
+	_, err := postXML(ctx, jenkins, computerEndpoint(name)+"/config.xml", config)
+	return err
+}
+
+type nodeDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber          = (*nodeDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber       = (*nodeDiscovery)(nil)
+	_ discovery_kit_sdk.EnrichmentRulesDescriber = (*nodeDiscovery)(nil)
+)
+
+func NewNodeDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &nodeDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 1*time.Minute),
+	)
+}
+
+func (d *nodeDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeNode,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *nodeDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeNode,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconNode),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Node", Other: "Jenkins Nodes"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.node.name"},
+				{Attribute: "jenkins.node.label"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.node.name",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.node.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node name",
+				Other: "Node names",
+			},
+		},
+		{
+			Attribute: "jenkins.node.label",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node label",
+				Other: "Node labels",
+			},
+		},
+		{
+			Attribute: "jenkins.node.builtin",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node is built-in",
+				Other: "Node is built-in",
+			},
+		},
+		{
+			Attribute: "jenkins.node.executors",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node executors",
+				Other: "Node executors",
+			},
+		},
+		{
+			Attribute: "jenkins.node.class",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node class",
+				Other: "Node classes",
+			},
+		},
+		{
+			Attribute: "jenkins.node.k8s.pod.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node pod name",
+				Other: "Node pod names",
+			},
+		},
+		{
+			Attribute: "jenkins.node.k8s.namespace",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Node pod namespace",
+				Other: "Node pod namespaces",
+			},
+		},
+	}
+}
+
+// nodeAttributes lists all attributes of node targets, which may be excluded through DiscoveryAttributesExcludesNode.
+var nodeAttributes = []string{
+	"jenkins.node.name",
+	"jenkins.node.label",
+	"jenkins.node.builtin",
+	"jenkins.node.executors",
+	"jenkins.node.class",
+	"jenkins.node.k8s.pod.name",
+	"jenkins.node.k8s.namespace",
+	"jenkins.cloud.name",
+	"jenkins.pod-template.name",
+}
+
+// DescribeEnrichmentRules adds the Jenkins node, cloud and pod template to the pods and containers of agents
+// provisioned by the Kubernetes plugin, as discovered by the Kubernetes and container extensions.
+func (d *nodeDiscovery) DescribeEnrichmentRules() []discovery_kit_api.TargetEnrichmentRule {
+	return []discovery_kit_api.TargetEnrichmentRule{
+		nodeToKubernetesEnrichmentRule("com.steadybit.extension_jenkins.node-to-kubernetes-pod", "com.steadybit.extension_kubernetes.kubernetes-pod"),
+		nodeToKubernetesEnrichmentRule("com.steadybit.extension_jenkins.node-to-container", "com.steadybit.extension_container.container"),
+	}
+}
+
+func nodeToKubernetesEnrichmentRule(id string, destTargetType string) discovery_kit_api.TargetEnrichmentRule {
+	return discovery_kit_api.TargetEnrichmentRule{
+		Id:      id,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Src: discovery_kit_api.SourceOrDestination{
+			Type: TargetTypeNode,
+			Selector: map[string]string{
+				"jenkins.node.k8s.pod.name":  "${dest.k8s.pod.name}",
+				"jenkins.node.k8s.namespace": "${dest.k8s.namespace}",
+			},
+		},
+		Dest: discovery_kit_api.SourceOrDestination{
+			Type: destTargetType,
+			Selector: map[string]string{
+				"k8s.pod.name":  "${src.jenkins.node.k8s.pod.name}",
+				"k8s.namespace": "${src.jenkins.node.k8s.namespace}",
+			},
+		},
+		Attributes: []discovery_kit_api.Attribute{
+			{Matcher: discovery_kit_api.Equals, Name: "jenkins.node.name"},
+			{Matcher: discovery_kit_api.Equals, Name: "jenkins.cloud.name"},
+			{Matcher: discovery_kit_api.Equals, Name: "jenkins.pod-template.name"},
+		},
+	}
+}
+
+func (d *nodeDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.nodes", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	computers, err := getComputers(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeNode, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch nodes.", err)
+	}
+
+	// Nodes are linked to the clouds and pod templates that provisioned them. Nodes are discovered even if the clouds
+	// cannot be read.
+	clouds, err := getClouds(ctx, d.jenkins)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to fetch clouds, nodes are discovered without their cloud.")
+	}
+	agents := cloudAgentsByName(clouds)
+
+	var targets []discovery_kit_api.Target
+	for _, computer := range computers {
+		name := computer.DisplayName
+		if computer.Class == builtInComputerClass {
+			name = builtInNodeName
+		}
+		target := discovery_kit_api.Target{
+			Id:         name,
+			TargetType: TargetTypeNode,
+			Label:      computer.DisplayName,
+			Attributes: map[string][]string{
+				"jenkins.node.name":      {name},
+				"jenkins.node.builtin":   {strconv.FormatBool(computer.Class == builtInComputerClass)},
+				"jenkins.node.executors": {strconv.Itoa(computer.NumExecutors)},
+				"jenkins.node.class":     {computer.Class},
+			},
+		}
+		var labels []string
+		for _, label := range computer.AssignedLabels {
+			// Every node carries its own name as label.
+			if label.Name != computer.DisplayName {
+				labels = append(labels, label.Name)
+			}
+		}
+		if len(labels) > 0 {
+			target.Attributes["jenkins.node.label"] = labels
+		}
+		if agent, ok := agents[computer.DisplayName]; ok {
+			target.Attributes["jenkins.cloud.name"] = []string{agent.cloud}
+			if agent.template != "" {
+				target.Attributes["jenkins.pod-template.name"] = []string{agent.template}
+			}
+			if agent.namespace != "" {
+				target.Attributes["jenkins.node.k8s.pod.name"] = []string{computer.DisplayName}
+				target.Attributes["jenkins.node.k8s.namespace"] = []string{agent.namespace}
+			}
+		}
+		targets = append(targets, target)
+	}
+	observeDiscovery(TargetTypeNode, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesNode), nil
+}
+
+type provisionedAgent struct {
+	cloud     string
+	template  string
+	namespace string
+}
+
+func cloudAgentsByName(clouds []cloud) map[string]provisionedAgent {
+	agents := make(map[string]provisionedAgent)
+	for _, cloud := range clouds {
+		for _, agent := range cloud.Agents {
+			agents[agent.Name] = provisionedAgent{cloud: cloud.Name, namespace: agent.Namespace}
+		}
+		for _, template := range cloud.Templates {
+			for _, agent := range template.Agents {
+				agents[agent.Name] = provisionedAgent{cloud: cloud.Name, template: template.Name, namespace: agent.Namespace}
+			}
+		}
+	}
+	return agents
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const nodeReduceExecutorsActionId = TargetTypeNode + ".reduce-executors"
+
+var numExecutorsElement = regexp.MustCompile(`<numExecutors>\s*(\d+)\s*</numExecutors>`)
+
+// setBuiltInExecutorsScript sets the number of executors of the built-in node and returns the previous one. The
+// built-in node is configured as part of the global configuration and has no config.xml of its own.
+const setBuiltInExecutorsScript = `
+    def instance = jenkins.model.Jenkins.get()
+    def previous = instance.numExecutors
+    instance.setNumExecutors(%d)
+    return previous`
+
+type nodeReduceExecutorsAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[NodeReduceExecutorsActionState]         = (*nodeReduceExecutorsAction)(nil)
+	_ action_kit_sdk.ActionWithStop[NodeReduceExecutorsActionState] = (*nodeReduceExecutorsAction)(nil)
+)
+
+type NodeReduceExecutorsActionState struct {
+	NodeName  string
+	Executors int
+	// OriginalConfig is the config.xml of an agent before the action, restored as is.
+	OriginalConfig string
+	// OriginalExecutors is the number of executors of the built-in node before the action.
+	OriginalExecutors int
+	Applied           bool
+	Correlation       BuildCorrelation
+}
+
+func NewNodeReduceExecutorsAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[NodeReduceExecutorsActionState] {
+	return &nodeReduceExecutorsAction{jenkins: jenkins}
+}
+
+func (a *nodeReduceExecutorsAction) NewEmptyState() NodeReduceExecutorsActionState {
+	return NodeReduceExecutorsActionState{}
+}
+
+func (a *nodeReduceExecutorsAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          nodeReduceExecutorsActionId,
+		Label:       "Reduce Executors",
+		Description: "Lowers the number of executors of a node to simulate degraded build capacity. The original configuration is restored when the step ends.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconNode),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeNode,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "node name",
+					Query: "jenkins.node.name=\"\"",
+				},
+				{
+					Label: "node label",
+					Query: "jenkins.node.label=\"\"",
+				},
+			}),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long the number of executors is lowered."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "executors",
+				Label:        "Executors",
+				Description:  new("Number of executors of the node during the step. With 0, the node does not run builds. Builds already running are not affected."),
+				Type:         action_kit_api.ActionParameterTypeInteger,
+				DefaultValue: new("1"),
+				MinValue:     new(0),
+				Required:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *nodeReduceExecutorsAction) Prepare(ctx context.Context, state *NodeReduceExecutorsActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startActionSpan(ctx, "jenkins.node.reduce-executors.prepare", newBuildCorrelation(request.ExecutionContext), map[string]any{"jenkins.node.name": request.Target.Name})
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	state.NodeName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.node.name")[0]
+	state.Executors = extutil.ToInt(request.Config["executors"])
+	if state.Executors < 0 {
+		return nil, extension_kit.ToError("The number of executors must not be negative.", nil)
+	}
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *nodeReduceExecutorsAction) Start(ctx context.Context, state *NodeReduceExecutorsActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.reduce-executors.start", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	subject := fmt.Sprintf("node '%s'", state.NodeName)
+	if err = claimChange(nodeReduceExecutorsActionId, subject); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !state.Applied {
+			releaseChange(nodeReduceExecutorsActionId, subject)
+		}
+	}()
+
+	var (
+		original int
+		config   string
+	)
+	if state.NodeName == builtInNodeName {
+		if original, err = runScript[int](ctx, a.jenkins, "return jenkins.model.Jenkins.get().numExecutors"); err != nil {
+			return nil, toJenkinsError("Failed to fetch executors.", err)
+		}
+	} else {
+		if config, err = getNodeConfig(ctx, a.jenkins, state.NodeName); err != nil {
+			return nil, toJenkinsError("Failed to fetch node configuration.", err)
+		}
+		match := numExecutorsElement.FindStringSubmatch(config)
+		if match == nil {
+			return nil, extension_kit.ToError(fmt.Sprintf("The configuration of node '%s' does not define the number of executors.", state.NodeName), nil)
+		}
+		original, _ = strconv.Atoi(match[1])
+	}
+	if state.Executors >= original {
+		return nil, extension_kit.ToError(fmt.Sprintf("Node '%s' has %d executors, the number of executors must be lower to reduce them.", state.NodeName, original), nil)
+	}
+
+	log.Info().Str("node", state.NodeName).Int("executors", state.Executors).Msg("Reducing executors.")
+	if state.NodeName == builtInNodeName {
+		// The state is persisted also if the start fails. Marking the change as applied before submitting it makes the
+		// stop restore the original number, even if Jenkins applied the change but the response was lost.
+		state.OriginalExecutors = original
+		state.Applied = true
+		if _, err = runScript[int](ctx, a.jenkins, fmt.Sprintf(setBuiltInExecutorsScript, state.Executors)); err != nil {
+			return nil, toJenkinsError("Failed to reduce executors.", err)
+		}
+	} else {
+		reduced := numExecutorsElement.ReplaceAllLiteralString(config, fmt.Sprintf("<numExecutors>%d</numExecutors>", state.Executors))
+		state.OriginalConfig = config
+		state.Applied = true
+		if err = updateNodeConfig(ctx, a.jenkins, state.NodeName, reduced); err != nil {
+			return nil, toJenkinsError("Failed to reduce executors.", err)
+		}
+	}
+
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Executors of node '%s' reduced from %d to %d.", state.NodeName, original, state.Executors),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *nodeReduceExecutorsAction) Stop(ctx context.Context, state *NodeReduceExecutorsActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.reduce-executors.stop", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	if !state.Applied {
+		return nil, nil
+	}
+	log.Info().Str("node", state.NodeName).Msg("Restoring executors.")
+	if state.NodeName == builtInNodeName {
+		if _, err = runScript[int](ctx, a.jenkins, fmt.Sprintf(setBuiltInExecutorsScript, state.OriginalExecutors)); err != nil {
+			return nil, toJenkinsError("Failed to restore executors.", err)
+		}
+	} else if err = updateNodeConfig(ctx, a.jenkins, state.NodeName, state.OriginalConfig); err != nil {
+		return nil, toJenkinsError("Failed to restore node configuration.", err)
+	}
+	state.Applied = false
+	releaseChange(nodeReduceExecutorsActionId, fmt.Sprintf("node '%s'", state.NodeName))
+
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Executors of node '%s' restored.", state.NodeName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const agentConfig = `<?xml version="1.1" encoding="UTF-8"?>
+<slave>
+  <name>agent-1</name>
+  <numExecutors>
+    4
+  </numExecutors>
+  <label>linux docker</label>
+</slave>`
+
+// startNodeConfigServer serves the config.xml of agent-1. Submitted configurations are recorded and answered with
+// the status returned by submitStatus.
+func startNodeConfigServer(t *testing.T, submitStatus *atomic.Int32) (*gojenkins.Jenkins, *[]string) {
+	var submitted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimSuffix(r.URL.Path, "/") != "/computer/agent-1/config.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(agentConfig))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		submitted = append(submitted, string(body))
+		w.WriteHeader(int(submitStatus.Load()))
+	}))
+	t.Cleanup(server.Close)
+	jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+	jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+	return jenkins, &submitted
+}
+
+func TestNumExecutorsElement(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{name: "plain", config: "<slave><numExecutors>2</numExecutors></slave>", want: "2"},
+		{name: "whitespace", config: agentConfig, want: "4"},
+		{name: "missing", config: "<slave><name>agent-1</name></slave>"},
+		{name: "not a number", config: "<slave><numExecutors>two</numExecutors></slave>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := numExecutorsElement.FindStringSubmatch(tt.config)
+			if tt.want == "" {
+				assert.Nil(t, match)
+			} else {
+				require.NotNil(t, match)
+				assert.Equal(t, tt.want, match[1])
+			}
+		})
+	}
+}
+
+func TestReduceExecutors(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeReduceExecutorsAction{jenkins: jenkins}
+	state := &NodeReduceExecutorsActionState{NodeName: "agent-1", Executors: 1}
+
+	result, err := action.Start(t.Context(), state)
+	require.NoError(t, err)
+	assert.Equal(t, "- Executors of node 'agent-1' reduced from 4 to 1.", (*result.Messages)[0].Message)
+	require.Len(t, *submitted, 1)
+	assert.Equal(t, strings.Replace(agentConfig, "<numExecutors>\n    4\n  </numExecutors>", "<numExecutors>1</numExecutors>", 1), (*submitted)[0])
+
+	_, err = action.Start(t.Context(), &NodeReduceExecutorsActionState{NodeName: "agent-1", Executors: 0})
+	assert.ErrorContains(t, err, "Another step is already changing node 'agent-1'.")
+
+	_, err = action.Stop(t.Context(), state)
+	require.NoError(t, err)
+	require.Len(t, *submitted, 2)
+	assert.Equal(t, agentConfig, (*submitted)[1], "the original configuration is restored as is")
+	assert.Empty(t, activeChanges.keys)
+}
+
+func TestReduceExecutorsRestoresAfterFailedSubmission(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeReduceExecutorsAction{jenkins: jenkins}
+	state := &NodeReduceExecutorsActionState{NodeName: "agent-1", Executors: 1}
+
+	_, err := action.Start(t.Context(), state)
+	assert.ErrorContains(t, err, "Failed to reduce executors.")
+	assert.True(t, state.Applied, "the change may have been applied")
+
+	status.Store(http.StatusOK)
+	_, err = action.Stop(t.Context(), state)
+	require.NoError(t, err)
+	require.Len(t, *submitted, 2)
+	assert.Equal(t, agentConfig, (*submitted)[1])
+	assert.Empty(t, activeChanges.keys)
+}
+
+func TestReduceExecutorsRejectsRaising(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeReduceExecutorsAction{jenkins: jenkins}
+
+	for _, executors := range []int{4, 5} {
+		state := &NodeReduceExecutorsActionState{NodeName: "agent-1", Executors: executors}
+		_, err := action.Start(t.Context(), state)
+
+		assert.ErrorContains(t, err, "Node 'agent-1' has 4 executors, the number of executors must be lower to reduce them.")
+		assert.False(t, state.Applied)
+	}
+	assert.Empty(t, *submitted)
+	assert.Empty(t, activeChanges.keys, "the node can be changed by other steps")
+}
@@ -0,0 +1,290 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const nodeRemoveLabelsActionId = TargetTypeNode + ".remove-labels"
+
+// setBuiltInLabelsScript sets the label string of the built-in node and returns the previous one.
+const setBuiltInLabelsScript = `
+    def instance = jenkins.model.Jenkins.get()
+    def previous = instance.labelString
+    instance.setLabelString(%s)
+    return previous`
+
+type nodeRemoveLabelsAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[NodeRemoveLabelsActionState]         = (*nodeRemoveLabelsAction)(nil)
+	_ action_kit_sdk.ActionWithStop[NodeRemoveLabelsActionState] = (*nodeRemoveLabelsAction)(nil)
+)
+
+type NodeRemoveLabelsActionState struct {
+	NodeName string
+	Labels   []string
+	// OriginalLabels is the label string of the node before the action, restored as is.
+	OriginalLabels string
+	Applied        bool
+	Correlation    BuildCorrelation
+}
+
+func NewNodeRemoveLabelsAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[NodeRemoveLabelsActionState] {
+	return &nodeRemoveLabelsAction{jenkins: jenkins}
+}
+
+func (a *nodeRemoveLabelsAction) NewEmptyState() NodeRemoveLabelsActionState {
+	return NodeRemoveLabelsActionState{}
+}
+
+func (a *nodeRemoveLabelsAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          nodeRemoveLabelsActionId,
+		Label:       "Remove Labels",
+		Description: "Removes labels from a node, so builds requiring them can no longer be scheduled on it. The original labels are restored when the step ends.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(TargetIconNode),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType: TargetTypeNode,
+			SelectionTemplates: new([]action_kit_api.TargetSelectionTemplate{
+				{
+					Label: "node name",
+					Query: "jenkins.node.name=\"\"",
+				},
+				{
+					Label: "node label",
+					Query: "jenkins.node.label=\"\"",
+				},
+			}),
+		}),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long the labels are removed."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:        "labels",
+				Label:       "Labels",
+				Description: new("Labels to remove from the node. Builds requiring only these labels wait in the queue if no other node has them."),
+				Type:        action_kit_api.ActionParameterTypeStringArray,
+				Required:    new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *nodeRemoveLabelsAction) Prepare(ctx context.Context, state *NodeRemoveLabelsActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startActionSpan(ctx, "jenkins.node.remove-labels.prepare", newBuildCorrelation(request.ExecutionContext), map[string]any{"jenkins.node.name": request.Target.Name})
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	state.NodeName = extutil.MustHaveValue(request.Target.Attributes, "jenkins.node.name")[0]
+	for _, label := range extutil.ToStringArray(request.Config["labels"]) {
+		state.Labels = append(state.Labels, strings.Fields(label)...)
+	}
+	if len(state.Labels) == 0 {
+		return nil, extension_kit.ToError("At least one label must be given.", nil)
+	}
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *nodeRemoveLabelsAction) Start(ctx context.Context, state *NodeRemoveLabelsActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.remove-labels.start", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	subject := fmt.Sprintf("node '%s'", state.NodeName)
+	if err = claimChange(nodeRemoveLabelsActionId, subject); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !state.Applied {
+			releaseChange(nodeRemoveLabelsActionId, subject)
+		}
+	}()
+
+	var config string
+	if state.NodeName == builtInNodeName {
+		if state.OriginalLabels, err = runScript[string](ctx, a.jenkins, "return jenkins.model.Jenkins.get().labelString"); err != nil {
+			return nil, toJenkinsError("Failed to fetch node labels.", err)
+		}
+	} else {
+		if config, err = getNodeConfig(ctx, a.jenkins, state.NodeName); err != nil {
+			return nil, toJenkinsError("Failed to fetch node configuration.", err)
+		}
+		start, _, labels, err := nodeLabelElement(config)
+		if err != nil {
+			return nil, extension_kit.ToError(fmt.Sprintf("Failed to parse the configuration of node '%s'.", state.NodeName), err)
+		}
+		if start < 0 {
+			return nil, extension_kit.ToError(fmt.Sprintf("The configuration of node '%s' does not define labels.", state.NodeName), nil)
+		}
+		state.OriginalLabels = labels
+	}
+
+	var removed, remaining []string
+	for _, label := range strings.Fields(state.OriginalLabels) {
+		if slices.Contains(state.Labels, label) {
+			removed = append(removed, label)
+		} else {
+			remaining = append(remaining, label)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, extension_kit.ToError(fmt.Sprintf("Node '%s' has none of the labels %s.", state.NodeName, strings.Join(state.Labels, ", ")), nil)
+	}
+
+	log.Info().Str("node", state.NodeName).Strs("labels", removed).Msg("Removing labels.")
+	// The state is persisted also if the start fails. Marking the change as applied before submitting it makes the stop
+	// restore the original labels, even if Jenkins applied the change but the response was lost.
+	state.Applied = true
+	if err = a.setLabels(ctx, state.NodeName, config, strings.Join(remaining, " ")); err != nil {
+		return nil, toJenkinsError("Failed to remove labels.", err)
+	}
+
+	return &action_kit_api.StartResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Labels %s removed from node '%s'.", strings.Join(removed, ", "), state.NodeName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+func (a *nodeRemoveLabelsAction) Stop(ctx context.Context, state *NodeRemoveLabelsActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.node.remove-labels.stop", state.Correlation, map[string]any{"jenkins.node.name": state.NodeName})
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", state.NodeName)
+
+	if !state.Applied {
+		return nil, nil
+	}
+	log.Info().Str("node", state.NodeName).Str("labels", state.OriginalLabels).Msg("Restoring labels.")
+	// Only the labels are restored, other changes to the node made in the meantime are kept.
+	var config string
+	if state.NodeName != builtInNodeName {
+		if config, err = getNodeConfig(ctx, a.jenkins, state.NodeName); err != nil {
+			return nil, toJenkinsError("Failed to fetch node configuration.", err)
+		}
+	}
+	if err = a.setLabels(ctx, state.NodeName, config, state.OriginalLabels); err != nil {
+		return nil, toJenkinsError("Failed to restore labels.", err)
+	}
+	state.Applied = false
+	releaseChange(nodeRemoveLabelsActionId, fmt.Sprintf("node '%s'", state.NodeName))
+
+	return &action_kit_api.StopResult{
+		Messages: &[]action_kit_api.Message{
+			{
+				Message: fmt.Sprintf("- Labels of node '%s' restored.", state.NodeName),
+				Type:    new("JENKINS"),
+			},
+		},
+	}, nil
+}
+
+// setLabels sets the label string of a node. For agents, the label element of the given config.xml is replaced.
+func (a *nodeRemoveLabelsAction) setLabels(ctx context.Context, nodeName string, config string, labels string) error {
+	if nodeName == builtInNodeName {
+		_, err := runScript[string](ctx, a.jenkins, fmt.Sprintf(setBuiltInLabelsScript, groovyString(labels)))
+		return err
+	}
+	start, end, _, err := nodeLabelElement(config)
+	if err != nil {
+		return fmt.Errorf("failed to parse the configuration of node '%s': %w", nodeName, err)
+	}
+	if start < 0 {
+		return fmt.Errorf("the configuration of node '%s' does not define labels", nodeName)
+	}
+	var escaped bytes.Buffer
+	if err := xml.EscapeText(&escaped, []byte(labels)); err != nil {
+		return err
+	}
+	return updateNodeConfig(ctx, a.jenkins, nodeName, config[:start]+"<label>"+escaped.String()+"</label>"+config[end:])
+}
+
+// nodeLabelElement locates the label element directly under the root element of a node's config.xml. Launchers and
+// node properties may define label elements of their own, which are left alone. It returns the offsets of the element
+// in the configuration and its unescaped text. The offsets are -1 if the node defines no labels.
+func nodeLabelElement(config string) (start int, end int, labels string, err error) {
+	offset := 0
+	if strings.HasPrefix(config, "<?xml") {
+		// Jenkins declares XML 1.1, which the decoder rejects, so the declaration is skipped.
+		if offset = strings.Index(config, "?>") + len("?>"); offset < len("?>") {
+			return -1, -1, "", errors.New("unterminated XML declaration")
+		}
+	}
+	decoder := xml.NewDecoder(strings.NewReader(config[offset:]))
+	decoder.Strict = false
+	depth := 0
+	start = -1
+	var text strings.Builder
+	for {
+		tokenStart := offset + int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return -1, -1, "", nil
+		}
+		if err != nil {
+			return -1, -1, "", err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && token.Name.Local == "label" {
+				start = tokenStart
+			}
+		case xml.CharData:
+			if start >= 0 {
+				text.Write(token)
+			}
+		case xml.EndElement:
+			if start >= 0 {
+				return start, offset + int(decoder.InputOffset()), text.String(), nil
+			}
+			depth--
+		}
+	}
+}
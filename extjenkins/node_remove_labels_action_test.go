@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeLabelElement(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     string
+		wantLabels string
+		replaced   string
+	}{
+		{name: "labels", config: "<slave><label>linux docker</label></slave>", wantLabels: "linux docker", replaced: "<slave><label>new</label></slave>"},
+		{name: "empty", config: "<slave><label></label></slave>", replaced: "<slave><label>new</label></slave>"},
+		{name: "self-closing", config: "<slave><label/></slave>", replaced: "<slave><label>new</label></slave>"},
+		{name: "self-closing with space", config: "<slave><label /></slave>", replaced: "<slave><label>new</label></slave>"},
+		{name: "escaped", config: "<slave><label>a&amp;b</label></slave>", wantLabels: "a&b", replaced: "<slave><label>new</label></slave>"},
+		{name: "xml 1.1", config: agentConfig, wantLabels: "linux docker", replaced: strings.Replace(agentConfig, "<label>linux docker</label>", "<label>new</label>", 1)},
+		{name: "nested labels", config: "<slave><launcher><label>ssh</label></launcher><label>linux</label><nodeProperties><label>x</label></nodeProperties></slave>",
+			wantLabels: "linux", replaced: "<slave><launcher><label>ssh</label></launcher><label>new</label><nodeProperties><label>x</label></nodeProperties></slave>"},
+		{name: "nested labels only", config: "<slave><launcher><label>ssh</label></launcher></slave>"},
+		{name: "missing", config: "<slave><labelString>x</labelString></slave>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, labels, err := nodeLabelElement(tt.config)
+			require.NoError(t, err)
+			if tt.replaced == "" {
+				assert.Equal(t, -1, start)
+				return
+			}
+			assert.Equal(t, tt.wantLabels, labels)
+			assert.Equal(t, tt.replaced, tt.config[:start]+"<label>new</label>"+tt.config[end:])
+		})
+	}
+}
+
+func TestRemoveLabels(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeRemoveLabelsAction{jenkins: jenkins}
+	state := &NodeRemoveLabelsActionState{NodeName: "agent-1", Labels: []string{"docker", "gpu"}}
+
+	result, err := action.Start(t.Context(), state)
+	require.NoError(t, err)
+	assert.Equal(t, "- Labels docker removed from node 'agent-1'.", (*result.Messages)[0].Message)
+	assert.Equal(t, "linux docker", state.OriginalLabels)
+	require.Len(t, *submitted, 1)
+	assert.Equal(t, strings.Replace(agentConfig, "<label>linux docker</label>", "<label>linux</label>", 1), (*submitted)[0])
+
+	_, err = action.Start(t.Context(), &NodeRemoveLabelsActionState{NodeName: "agent-1", Labels: []string{"linux"}})
+	assert.ErrorContains(t, err, "Another step is already changing node 'agent-1'.")
+
+	_, err = action.Stop(t.Context(), state)
+	require.NoError(t, err)
+	require.Len(t, *submitted, 2)
+	assert.Equal(t, agentConfig, (*submitted)[1])
+	assert.Empty(t, activeChanges.keys)
+}
+
+func TestRemoveLabelsRestoresAfterFailedSubmission(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeRemoveLabelsAction{jenkins: jenkins}
+	state := &NodeRemoveLabelsActionState{NodeName: "agent-1", Labels: []string{"docker"}}
+
+	_, err := action.Start(t.Context(), state)
+	assert.ErrorContains(t, err, "Failed to remove labels.")
+	assert.True(t, state.Applied, "the change may have been applied")
+
+	status.Store(http.StatusOK)
+	_, err = action.Stop(t.Context(), state)
+	require.NoError(t, err)
+	require.Len(t, *submitted, 2)
+	assert.Equal(t, agentConfig, (*submitted)[1])
+	assert.Empty(t, activeChanges.keys)
+}
+
+func TestRemoveLabelsRejectsUnknownLabels(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	jenkins, submitted := startNodeConfigServer(t, &status)
+	action := &nodeRemoveLabelsAction{jenkins: jenkins}
+	state := &NodeRemoveLabelsActionState{NodeName: "agent-1", Labels: []string{"gpu"}}
+
+	_, err := action.Start(t.Context(), state)
+
+	assert.ErrorContains(t, err, "Node 'agent-1' has none of the labels gpu.")
+	assert.False(t, state.Applied)
+	assert.Empty(t, *submitted)
+	assert.Empty(t, activeChanges.keys, "the node can be changed by other steps")
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+)
+
+type whoAmI struct {
+	Name          string   `json:"name"`
+	Anonymous     bool     `json:"anonymous"`
+	Authenticated bool     `json:"authenticated"`
+	Authorities   []string `json:"authorities"`
+}
+
+type me struct {
+	Id       string `json:"id"`
+	FullName string `json:"fullName"`
+}
+
+// errNoBuildableJob is returned if Job/Build cannot be checked, because there is no buildable job at the top level.
+var errNoBuildableJob = errors.New("no buildable job at the top level")
+
+// CheckPermissions verifies that Jenkins accepts the configured credentials and grants Overall/Read and Job/Build. All
+// missing permissions are reported in a single error. Jenkins does not expose the permissions of a user via its REST
+// API, so Job/Build is checked by opening the build page of the first buildable top-level job, which Jenkins denies
+// without the permission but never starts a build for.
+func CheckPermissions(ctx context.Context, jenkins *gojenkins.Jenkins) error {
+	var identity whoAmI
+	if _, err := jenkins.Requester.GetJSON(ctx, "/whoAmI", &identity, nil); err != nil {
+		return fmt.Errorf("failed to check the identity of the API user: %w", err)
+	}
+
+	var missing []string
+	if identity.Anonymous || !identity.Authenticated {
+		missing = append(missing, "Authentication (Jenkins treats the requests as anonymous, check the credentials)")
+	}
+
+	var user me
+	if _, err := jenkins.Requester.GetJSON(ctx, "/me", &user, nil); err != nil {
+		if !isDenied(err) {
+			return fmt.Errorf("failed to check the permissions of the API user: %w", err)
+		}
+		missing = append(missing, "Overall/Read")
+	} else {
+		job, err := checkBuildPermission(ctx, jenkins)
+		switch {
+		case errors.Is(err, errNoBuildableJob):
+			log.Info().Msg("Skipped the check of the Job/Build permission, as there is no buildable job at the top level.")
+		case isDenied(err):
+			missing = append(missing, fmt.Sprintf("Job/Build (checked on job '%s')", job))
+		case err != nil:
+			return fmt.Errorf("failed to check the Job/Build permission of the API user: %w", err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the API user '%s' lacks required permissions: %s", identity.Name, strings.Join(missing, ", "))
+	}
+	log.Info().Str("user", user.Id).Strs("authorities", identity.Authorities).Msg("Jenkins accepted the API user.")
+	return nil
+}
+
+// checkBuildPermission opens the build page of the first buildable top-level job and returns the job's name. Jenkins
+// checks Job/Build before it answers a GET with 405 Method Not Allowed, or with the parameter form of parameterized
+// jobs.
+func checkBuildPermission(ctx context.Context, jenkins *gojenkins.Jenkins) (string, error) {
+	var root struct {
+		Jobs []struct {
+			Name      string `json:"name"`
+			Buildable *bool  `json:"buildable"`
+		} `json:"jobs"`
+	}
+	if _, err := jenkins.Requester.GetJSON(ctx, "/", &root, map[string]string{"tree": "jobs[name,buildable]"}); err != nil {
+		return "", err
+	}
+	for _, job := range root.Jobs {
+		if job.Buildable == nil || !*job.Buildable {
+			continue
+		}
+		var page string
+		_, err := jenkins.Requester.Get(ctx, "/job/"+url.PathEscape(job.Name)+"/build", &page, nil)
+		var apiErr *JenkinsApiError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusMethodNotAllowed {
+			return job.Name, nil
+		}
+		return job.Name, err
+	}
+	return "", errNoBuildableJob
+}
+
+func isDenied(err error) bool {
+	var apiErr *JenkinsApiError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusForbidden || apiErr.StatusCode == http.StatusUnauthorized)
+}
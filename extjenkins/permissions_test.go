@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		me          int
+		jobs        string
+		build       int
+		wantErr     string
+		wantNoBuild bool
+	}{
+		{name: "all granted", me: http.StatusOK, jobs: `[{"name":"a","buildable":true}]`, build: http.StatusMethodNotAllowed},
+		{name: "parameterized job", me: http.StatusOK, jobs: `[{"name":"a","buildable":true}]`, build: http.StatusOK},
+		{name: "no buildable job", me: http.StatusOK, jobs: `[{"name":"folder"},{"name":"disabled","buildable":false}]`, wantNoBuild: true},
+		{name: "missing Job/Build", me: http.StatusOK, jobs: `[{"name":"a","buildable":true}]`, build: http.StatusForbidden, wantErr: "the API user 'bot' lacks required permissions: Job/Build (checked on job 'a')"},
+		{name: "missing Overall/Read", me: http.StatusForbidden, wantErr: "the API user 'bot' lacks required permissions: Overall/Read", wantNoBuild: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buildChecked := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/whoAmI/api/json":
+					_, _ = w.Write([]byte(`{"name":"bot","anonymous":false,"authenticated":true}`))
+				case "/me/api/json":
+					w.WriteHeader(tt.me)
+					_, _ = w.Write([]byte(`{"id":"bot"}`))
+				case "/api/json":
+					_, _ = w.Write([]byte(`{"jobs":` + tt.jobs + `}`))
+				case "/job/a/build/":
+					assert.Equal(t, http.MethodGet, r.Method)
+					buildChecked = true
+					w.WriteHeader(tt.build)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+			jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+
+			err := CheckPermissions(t.Context(), jenkins)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Equal(t, !tt.wantNoBuild, buildChecked)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	extension_kit "github.com/steadybit/extension-kit"
+)
+
+// plugin is an installed plugin, as listed by /pluginManager/api/json.
+type plugin struct {
+	ShortName string `json:"shortName"`
+	Version   string `json:"version"`
+	Active    bool   `json:"active"`
+	HasUpdate bool   `json:"hasUpdate"`
+}
+
+type plugins struct {
+	Plugins []plugin `json:"plugins"`
+}
+
+var warnPluginsDenied sync.Once
+
+// getPlugins lists the installed plugins. Listing them requires the Overall/Administer or Overall/SystemRead
+// permission. Without it, nil is returned.
+func getPlugins(ctx context.Context, jenkins *gojenkins.Jenkins) ([]plugin, error) {
+	var response plugins
+	query := map[string]string{"tree": "plugins[shortName,version,active,hasUpdate]"}
+	_, err := jenkins.Requester.GetJSON(ctx, "/pluginManager", &response, query)
+	if isForbidden(err) {
+		warnPluginsDenied.Do(func() {
+			log.Warn().Msg("Plugins are not discovered, listing them requires the Overall/Administer or Overall/SystemRead permission.")
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response.Plugins, nil
+}
+
+// checkPluginsInstalled rejects actions depending on plugins which are not installed or not active. If the plugins
+// cannot be listed for lack of permissions, the check is skipped.
+func checkPluginsInstalled(ctx context.Context, jenkins *gojenkins.Jenkins, shortNames ...string) error {
+	if len(shortNames) == 0 {
+		return nil
+	}
+	installed, err := getPlugins(ctx, jenkins)
+	if err != nil {
+		return toJenkinsError("Failed to list installed plugins.", err)
+	}
+	if installed == nil {
+		return nil
+	}
+	var missing []string
+	for _, shortName := range shortNames {
+		if !slices.ContainsFunc(installed, func(p plugin) bool { return p.ShortName == shortName && p.Active }) {
+			missing = append(missing, shortName)
+		}
+	}
+	if len(missing) > 0 {
+		return extension_kit.ToError(fmt.Sprintf("These plugins are required, but not installed in Jenkins: %s", strings.Join(missing, ", ")), nil)
+	}
+	return nil
+}
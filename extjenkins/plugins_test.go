@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPluginsInstalled(t *testing.T) {
+	installed := `{"plugins":[{"shortName":"lockable-resources","active":true},{"shortName":"kubernetes","active":false}]}`
+	tests := []struct {
+		name         string
+		status       int
+		plugins      []string
+		wantErr      string
+		wantRequests int
+	}{
+		{name: "installed", status: http.StatusOK, plugins: []string{"lockable-resources"}, wantRequests: 1},
+		{name: "inactive", status: http.StatusOK, plugins: []string{"lockable-resources", "kubernetes"}, wantErr: "These plugins are required, but not installed in Jenkins: kubernetes", wantRequests: 1},
+		{name: "missing", status: http.StatusOK, plugins: []string{"configuration-as-code", "kubernetes"}, wantErr: "These plugins are required, but not installed in Jenkins: configuration-as-code, kubernetes", wantRequests: 1},
+		{name: "not listable", status: http.StatusForbidden, plugins: []string{"configuration-as-code"}, wantRequests: 1},
+		{name: "failure", status: http.StatusNotFound, plugins: []string{"lockable-resources"}, wantErr: "Failed to list installed plugins.", wantRequests: 1},
+		{name: "nothing required", status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/pluginManager/api/json" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				requests++
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(installed))
+			}))
+			defer server.Close()
+			jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+			jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+
+			err := checkPluginsInstalled(t.Context(), jenkins, tt.plugins...)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantRequests, requests)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+type podTemplateDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*podTemplateDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*podTemplateDiscovery)(nil)
+)
+
+func NewPodTemplateDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &podTemplateDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 1*time.Minute),
+	)
+}
+
+func (d *podTemplateDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypePodTemplate,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *podTemplateDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypePodTemplate,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconPodTemplate),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins Pod Template", Other: "Jenkins Pod Templates"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.pod-template.name"},
+				{Attribute: "jenkins.cloud.name"},
+				{Attribute: "jenkins.pod-template.agent.count"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.pod-template.name",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *podTemplateDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.pod-template.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template name",
+				Other: "Pod template names",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.id",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template id",
+				Other: "Pod template ids",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.label",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template label",
+				Other: "Pod template labels",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.cap",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template instance cap",
+				Other: "Pod template instance caps",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.namespace",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template namespace",
+				Other: "Pod template namespaces",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.agent",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template agent",
+				Other: "Pod template agents",
+			},
+		},
+		{
+			Attribute: "jenkins.pod-template.agent.count",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Pod template agent count",
+				Other: "Pod template agent counts",
+			},
+		},
+	}
+}
+
+// podTemplateAttributes lists all attributes of pod template targets, which may be excluded through
+// DiscoveryAttributesExcludesPodTemplate.
+var podTemplateAttributes = []string{
+	"jenkins.pod-template.name",
+	"jenkins.pod-template.id",
+	"jenkins.pod-template.label",
+	"jenkins.pod-template.cap",
+	"jenkins.pod-template.namespace",
+	"jenkins.pod-template.agent",
+	"jenkins.pod-template.agent.count",
+	"jenkins.cloud.name",
+	"jenkins.cloud.type",
+}
+
+func (d *podTemplateDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.pod-templates", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	clouds, err := getClouds(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypePodTemplate, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch pod templates.", err)
+	}
+
+	var targets []discovery_kit_api.Target
+	for _, cloud := range clouds {
+		for _, template := range cloud.Templates {
+			target := discovery_kit_api.Target{
+				Id:         cloud.Name + "/" + template.Name,
+				TargetType: TargetTypePodTemplate,
+				Label:      template.Name,
+				Attributes: map[string][]string{
+					"jenkins.pod-template.name":        {template.Name},
+					"jenkins.pod-template.id":          {template.Id},
+					"jenkins.pod-template.agent.count": {strconv.Itoa(len(template.Agents))},
+					"jenkins.cloud.name":               {cloud.Name},
+					"jenkins.cloud.type":               {cloud.Type},
+				},
+			}
+			if labels := strings.Fields(template.Labels); len(labels) > 0 {
+				target.Attributes["jenkins.pod-template.label"] = labels
+			}
+			if instanceCap := capAttribute(template.Cap); instanceCap != nil {
+				target.Attributes["jenkins.pod-template.cap"] = instanceCap
+			}
+			if template.Namespace != "" {
+				target.Attributes["jenkins.pod-template.namespace"] = []string{template.Namespace}
+			}
+			if len(template.Agents) > 0 {
+				target.Attributes["jenkins.pod-template.agent"] = agentNames(template.Agents)
+			}
+			targets = append(targets, target)
+		}
+	}
+	observeDiscovery(TargetTypePodTemplate, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesPodTemplate), nil
+}
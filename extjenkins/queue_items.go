@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+)
+
+// Number of builds fetched per request when searching a job's builds for queue ids.
+const queueIdLookupBuildsLimit = 50
+
+// resolveQueueItem returns the queue item and the number of the build started for it. The build number is 0 while the
+// item is still waiting in the queue. Jenkins removes left items from the queue after about five minutes, in that case
+// the returned task is nil and the build is looked up among the job's builds by its queue id. Errors are returned as
+// received, so callers can tell transient ones apart.
+func resolveQueueItem(ctx context.Context, jenkins *gojenkins.Jenkins, jobName string, parentIds []string, queueId int64) (*gojenkins.Task, int64, error) {
+	task, err := jenkins.GetQueueItem(ctx, queueId)
+	if err == nil {
+		return task, task.Raw.Executable.Number, nil
+	}
+	log.Debug().Err(err).Int64("queueId", queueId).Msg("Queue item not available, searching the job's builds.")
+
+	job, jobErr := jenkins.GetJob(ctx, jobName, parentIds...)
+	if jobErr != nil {
+		return nil, 0, fmt.Errorf("failed to find job: %w", jobErr)
+	}
+	runIds, buildsErr := findRunIdsSinceQueueId(ctx, job, queueId)
+	if buildsErr != nil {
+		return nil, 0, fmt.Errorf("failed to fetch builds: %w", buildsErr)
+	}
+	runId := runIds[queueId]
+	if runId == 0 {
+		return nil, 0, err
+	}
+	log.Info().Int64("queueId", queueId).Int64("runId", runId).Msg("Resolved evicted queue item to build.")
+	return nil, runId, nil
+}
+
+// findRunIdsSinceQueueId maps the queue ids of the job's builds to their build numbers. Queue ids grow with every
+// queued item, so the builds are searched from the newest one back to the first build queued before lowestQueueId.
+func findRunIdsSinceQueueId(ctx context.Context, job *gojenkins.Job, lowestQueueId int64) (map[int64]int64, error) {
+	runIds := map[int64]int64{}
+	for from := 0; ; from += queueIdLookupBuildsLimit {
+		var response struct {
+			AllBuilds []struct {
+				Number  int64 `json:"number"`
+				QueueId int64 `json:"queueId"`
+			} `json:"allBuilds"`
+		}
+		query := map[string]string{"tree": fmt.Sprintf("allBuilds[number,queueId]{%d,%d}", from, from+queueIdLookupBuildsLimit)}
+		if _, err := job.Jenkins.Requester.GetJSON(ctx, job.Base, &response, query); err != nil {
+			return nil, err
+		}
+		reachedOlderBuilds := false
+		for _, build := range response.AllBuilds {
+			if build.QueueId < lowestQueueId {
+				reachedOlderBuilds = true
+				continue
+			}
+			runIds[build.QueueId] = build.Number
+		}
+		if reachedOlderBuilds || len(response.AllBuilds) < queueIdLookupBuildsLimit {
+			return runIds, nil
+		}
+	}
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	retryMaxAttempts  = 3
+	retryBaseDelay    = 200 * time.Millisecond
+	retryMaxDelay     = 2 * time.Second
+	retryBudgetMax    = 10.0
+	retryBudgetRefill = 0.1
+)
+
+// retryingRequester decorates a gojenkins.JenkinsRequester. Error statuses and failed connections are turned into
+// JenkinsApiErrors, and idempotent GET requests failing transiently are retried with a jittered exponential backoff.
+// Retries draw from a budget shared by all requests, which refills with every successful request. This way a
+// Jenkins being down for longer is not hammered with retries.
+type retryingRequester struct {
+	delegate gojenkins.JenkinsRequester
+
+	mu     sync.Mutex
+	budget float64
+}
+
+var _ gojenkins.JenkinsRequester = (*retryingRequester)(nil)
+
+func NewRetryingRequester(delegate gojenkins.JenkinsRequester) gojenkins.JenkinsRequester {
+	return &retryingRequester{delegate: delegate, budget: retryBudgetMax}
+}
+
+func (r *retryingRequester) GetJSON(ctx context.Context, endpoint string, response interface{}, query map[string]string) (*http.Response, error) {
+	return r.retry(ctx, endpoint, func() (*http.Response, error) {
+		return r.delegate.GetJSON(ctx, endpoint, response, query)
+	})
+}
+
+func (r *retryingRequester) Get(ctx context.Context, endpoint string, response interface{}, query map[string]string) (*http.Response, error) {
+	return r.retry(ctx, endpoint, func() (*http.Response, error) {
+		return r.delegate.Get(ctx, endpoint, response, query)
+	})
+}
+
+func (r *retryingRequester) GetXML(ctx context.Context, endpoint string, response interface{}, query map[string]string) (*http.Response, error) {
+	return r.retry(ctx, endpoint, func() (*http.Response, error) {
+		return r.delegate.GetXML(ctx, endpoint, response, query)
+	})
+}
+
+func (r *retryingRequester) Post(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string) (*http.Response, error) {
+	return classifyResponse(endpoint)(r.delegate.Post(ctx, endpoint, payload, response, query))
+}
+
+func (r *retryingRequester) PostXML(ctx context.Context, endpoint string, xml string, response interface{}, query map[string]string) (*http.Response, error) {
+	return classifyResponse(endpoint)(r.delegate.PostXML(ctx, endpoint, xml, response, query))
+}
+
+func (r *retryingRequester) PostJSON(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string) (*http.Response, error) {
+	return classifyResponse(endpoint)(r.delegate.PostJSON(ctx, endpoint, payload, response, query))
+}
+
+func (r *retryingRequester) PostFiles(ctx context.Context, endpoint string, payload io.Reader, response interface{}, query map[string]string, files []string) (*http.Response, error) {
+	return classifyResponse(endpoint)(r.delegate.PostFiles(ctx, endpoint, payload, response, query, files))
+}
+
+func (r *retryingRequester) retry(ctx context.Context, endpoint string, call func() (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := classifyResponse(endpoint)(call())
+
+		var apiErr *JenkinsApiError
+		if err == nil || !errors.As(err, &apiErr) || !apiErr.IsTransient() {
+			r.refill()
+			return response, err
+		}
+		if attempt >= retryMaxAttempts || ctx.Err() != nil || !r.withdraw() {
+			return response, err
+		}
+
+		delay := min(retryBaseDelay<<(attempt-1), retryMaxDelay)
+		delay = delay/2 + rand.N(delay/2)
+		log.Debug().Err(err).Int("attempt", attempt).Dur("delay", delay).Msg("Retrying Jenkins request.")
+		select {
+		case <-ctx.Done():
+			return response, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (r *retryingRequester) withdraw() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.budget < 1 {
+		return false
+	}
+	r.budget--
+	return true
+}
+
+func (r *retryingRequester) refill() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.budget = min(r.budget+retryBudgetRefill, retryBudgetMax)
+}
+
+// classifyResponse turns error statuses and failed connections into JenkinsApiErrors. gojenkins itself does not
+// check the status and fails with an unhelpful decoding error for the HTML error pages of Jenkins and proxies.
+func classifyResponse(endpoint string) func(*http.Response, error) (*http.Response, error) {
+	return func(response *http.Response, err error) (*http.Response, error) {
+		var apiErr *JenkinsApiError
+		if errors.As(err, &apiErr) {
+			return response, err
+		}
+		if response != nil && response.StatusCode >= http.StatusBadRequest {
+			return response, &JenkinsApiError{Endpoint: endpoint, StatusCode: response.StatusCode, Cause: err}
+		}
+		var authErr *AuthenticationError
+		if errors.As(err, &authErr) {
+			return response, err
+		}
+		var netErr net.Error
+		if response == nil && errors.As(err, &netErr) {
+			return nil, &JenkinsApiError{Endpoint: endpoint, Cause: err}
+		}
+		return response, err
+	}
+}
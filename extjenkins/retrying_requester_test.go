@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRequester answers requests with the given statuses in turn. A status of 0 stands for a refused connection.
+type stubRequester struct {
+	gojenkins.JenkinsRequester
+	statuses []int
+	calls    int
+}
+
+func (s *stubRequester) next() (*http.Response, error) {
+	status := s.statuses[min(s.calls, len(s.statuses)-1)]
+	s.calls++
+	if status == 0 {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+}
+
+func (s *stubRequester) GetJSON(context.Context, string, interface{}, map[string]string) (*http.Response, error) {
+	return s.next()
+}
+
+func (s *stubRequester) Post(context.Context, string, io.Reader, interface{}, map[string]string) (*http.Response, error) {
+	return s.next()
+}
+
+func TestClassifyResponse(t *testing.T) {
+	authErr := &AuthenticationError{Cause: errors.New("token expired")}
+	decodeErr := errors.New("invalid character '<' looking for beginning of value")
+	tests := []struct {
+		name          string
+		response      *http.Response
+		err           error
+		wantStatus    int
+		wantApiError  bool
+		wantTransient bool
+	}{
+		{name: "success", response: &http.Response{StatusCode: http.StatusOK}},
+		{name: "redirect", response: &http.Response{StatusCode: http.StatusFound}},
+		{name: "not found", response: &http.Response{StatusCode: http.StatusNotFound}, err: decodeErr, wantStatus: http.StatusNotFound, wantApiError: true},
+		{name: "forbidden", response: &http.Response{StatusCode: http.StatusForbidden}, wantStatus: http.StatusForbidden, wantApiError: true},
+		{name: "too many requests", response: &http.Response{StatusCode: http.StatusTooManyRequests}, wantStatus: http.StatusTooManyRequests, wantApiError: true, wantTransient: true},
+		{name: "bad gateway", response: &http.Response{StatusCode: http.StatusBadGateway}, err: decodeErr, wantStatus: http.StatusBadGateway, wantApiError: true, wantTransient: true},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, wantApiError: true, wantTransient: true},
+		{name: "authentication", err: authErr},
+		{name: "decoding", response: &http.Response{StatusCode: http.StatusOK}, err: decodeErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := classifyResponse("/job/a/")(tt.response, tt.err)
+
+			var apiErr *JenkinsApiError
+			if !tt.wantApiError {
+				assert.False(t, errors.As(err, &apiErr))
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, "/job/a/", apiErr.Endpoint)
+			assert.Equal(t, tt.wantStatus, apiErr.StatusCode)
+			assert.Equal(t, tt.wantTransient, apiErr.IsTransient())
+			assert.Equal(t, tt.wantTransient, isTransient(err))
+		})
+	}
+}
+
+func TestClassifyResponseKeepsClassifiedErrors(t *testing.T) {
+	original := &JenkinsApiError{Endpoint: "/first/", StatusCode: http.StatusBadGateway}
+
+	_, err := classifyResponse("/second/")(&http.Response{StatusCode: http.StatusBadGateway}, original)
+
+	assert.Same(t, original, err)
+}
+
+func TestRetryingRequester(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		budget     float64
+		wantCalls  int
+		wantStatus int
+	}{
+		{name: "success is not retried", statuses: []int{200}, budget: retryBudgetMax, wantCalls: 1},
+		{name: "client error is not retried", statuses: []int{404}, budget: retryBudgetMax, wantCalls: 1, wantStatus: 404},
+		{name: "transient error is retried", statuses: []int{503, 200}, budget: retryBudgetMax, wantCalls: 2},
+		{name: "refused connection is retried", statuses: []int{0, 0, 200}, budget: retryBudgetMax, wantCalls: 3},
+		{name: "attempts are limited", statuses: []int{502}, budget: retryBudgetMax, wantCalls: retryMaxAttempts, wantStatus: 502},
+		{name: "exhausted budget stops retries", statuses: []int{502}, budget: 0.5, wantCalls: 1, wantStatus: 502},
+		{name: "budget limits retries", statuses: []int{502}, budget: 1, wantCalls: 2, wantStatus: 502},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubRequester{statuses: tt.statuses}
+			requester := &retryingRequester{delegate: stub, budget: tt.budget}
+
+			_, err := requester.GetJSON(t.Context(), "/api/json", nil, nil)
+
+			assert.Equal(t, tt.wantCalls, stub.calls)
+			if tt.wantStatus == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var apiErr *JenkinsApiError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.wantStatus, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestRetryingRequesterDoesNotRetryPosts(t *testing.T) {
+	stub := &stubRequester{statuses: []int{503, 200}}
+	requester := NewRetryingRequester(stub)
+
+	_, err := requester.Post(t.Context(), "/job/a/build", nil, nil, nil)
+
+	assert.True(t, isTransient(err))
+	assert.Equal(t, 1, stub.calls)
+}
+
+func TestRetryBudget(t *testing.T) {
+	requester := &retryingRequester{budget: 2}
+
+	assert.True(t, requester.withdraw())
+	assert.True(t, requester.withdraw())
+	assert.False(t, requester.withdraw(), "budget is exhausted")
+
+	for range 11 {
+		requester.refill()
+	}
+	assert.True(t, requester.withdraw(), "successful requests refill the budget")
+	assert.False(t, requester.withdraw())
+
+	requester.budget = retryBudgetMax
+	requester.refill()
+	assert.Equal(t, retryBudgetMax, requester.budget, "budget is capped")
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	extension_kit "github.com/steadybit/extension-kit"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const scriptActionId = "com.steadybit.extension_jenkins.script"
+
+// userScriptTemplate evaluates a script given in an experiment in a shell of its own, so the output it prints is
+// captured and does not interfere with the JSON printed by scriptTemplate. Like the Script Console, it imports the
+// common Jenkins packages.
+const userScriptTemplate = `
+    def output = new StringWriter()
+    def configuration = new org.codehaus.groovy.control.CompilerConfiguration()
+    configuration.addCompilationCustomizers(new org.codehaus.groovy.control.customizers.ImportCustomizer().addStarImports('jenkins', 'jenkins.model', 'hudson', 'hudson.model'))
+    def shell = new GroovyShell(jenkins.model.Jenkins.get().pluginManager.uberClassLoader, new Binding([out: new PrintWriter(output, true)]), configuration)
+    try {
+      def value = shell.evaluate(%s)
+      return [output: output.toString(), value: value == null ? null : value.toString()]
+    } catch (Throwable e) {
+      return [output: output.toString(), error: e.toString()]
+    }`
+
+type userScriptResult struct {
+	Output string  `json:"output"`
+	Value  *string `json:"value"`
+	Error  string  `json:"error"`
+}
+
+type scriptAction struct {
+	jenkins *gojenkins.Jenkins
+}
+
+// Make sure action implements all required interfaces
+var (
+	_ action_kit_sdk.Action[ScriptActionState]         = (*scriptAction)(nil)
+	_ action_kit_sdk.ActionWithStop[ScriptActionState] = (*scriptAction)(nil)
+)
+
+type ScriptActionState struct {
+	Script         string
+	RollbackScript string
+	Started        bool
+	Correlation    BuildCorrelation
+}
+
+func NewScriptAction(jenkins *gojenkins.Jenkins) action_kit_sdk.Action[ScriptActionState] {
+	return &scriptAction{jenkins: jenkins}
+}
+
+func (a *scriptAction) NewEmptyState() ScriptActionState {
+	return ScriptActionState{}
+}
+
+func (a *scriptAction) Describe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          scriptActionId,
+		Label:       "Run Groovy Script",
+		Description: "Runs a Groovy script in the Script Console of Jenkins, for faults not covered by other actions. An optional rollback script runs when the step ends.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Technology:  new("Jenkins"),
+		Kind:        action_kit_api.Attack,
+		TimeControl: action_kit_api.TimeControlExternal,
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long until the rollback script runs."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("60s"),
+				Required:     new(true),
+			},
+			{
+				Name:        "script",
+				Label:       "Script",
+				Description: new("Groovy script to run. The script runs with full administrative rights. Its output and return value are shown in the Jenkins widget."),
+				Type:        action_kit_api.ActionParameterTypeTextarea,
+				Required:    new(true),
+			},
+			{
+				Name:        "rollbackScript",
+				Label:       "Rollback Script",
+				Description: new("Groovy script to run when the step ends, also if the script failed."),
+				Type:        action_kit_api.ActionParameterTypeTextarea,
+				Required:    new(false),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+		Widgets: new([]action_kit_api.Widget{
+			action_kit_api.MarkdownWidget{
+				Type:        action_kit_api.ComSteadybitWidgetMarkdown,
+				Title:       "Jenkins",
+				MessageType: "JENKINS",
+				Append:      true,
+			},
+		}),
+	}
+}
+
+func (a *scriptAction) Prepare(ctx context.Context, state *ScriptActionState, request action_kit_api.PrepareActionRequestBody) (_ *action_kit_api.PrepareResult, err error) {
+	_, span := startActionSpan(ctx, "jenkins.script.prepare", newBuildCorrelation(request.ExecutionContext), nil)
+	defer span.EndWithError(&err)
+
+	if err = checkMutationsAllowed(); err != nil {
+		return nil, err
+	}
+	if err = checkScriptsAllowed(); err != nil {
+		return nil, err
+	}
+	state.Script = extutil.ToString(request.Config["script"])
+	if strings.TrimSpace(state.Script) == "" {
+		return nil, extension_kit.ToError("The script must not be empty.", nil)
+	}
+	state.RollbackScript = extutil.ToString(request.Config["rollbackScript"])
+	state.Correlation = newBuildCorrelation(request.ExecutionContext)
+	return nil, nil
+}
+
+func (a *scriptAction) Start(ctx context.Context, state *ScriptActionState) (_ *action_kit_api.StartResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.script.start", state.Correlation, nil)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	// The rollback script runs even if the script failed halfway.
+	state.Started = true
+	log.Info().Str("script", state.Script).Msg("Running Groovy script.")
+	message, err := a.run(ctx, state.Script)
+	result := &action_kit_api.StartResult{Messages: scriptMessages(message)}
+	if err != nil {
+		return result, toJenkinsError("Failed to run script.", err)
+	}
+	return result, nil
+}
+
+func (a *scriptAction) Stop(ctx context.Context, state *ScriptActionState) (_ *action_kit_api.StopResult, err error) {
+	ctx, span := startActionSpan(ctx, "jenkins.script.stop", state.Correlation, nil)
+	defer span.EndWithError(&err)
+	ctx = withAuditSubject(ctx, state.Correlation, "", "")
+
+	if !state.Started || strings.TrimSpace(state.RollbackScript) == "" {
+		return nil, nil
+	}
+	log.Info().Str("script", state.RollbackScript).Msg("Running Groovy rollback script.")
+	message, err := a.run(ctx, state.RollbackScript)
+	result := &action_kit_api.StopResult{Messages: scriptMessages(message)}
+	if err != nil {
+		return result, toJenkinsError("Failed to run rollback script.", err)
+	}
+	state.Started = false
+	return result, nil
+}
+
+// run runs a script and returns its output as Markdown. If the script failed, the output printed until then is
+// returned along with the error.
+func (a *scriptAction) run(ctx context.Context, script string) (string, error) {
+	result, err := runScript[userScriptResult](ctx, a.jenkins, fmt.Sprintf(userScriptTemplate, groovyString(script)))
+	if err != nil {
+		return "", err
+	}
+	log.Info().Str("output", result.Output).Msg("Groovy script finished.")
+	var message strings.Builder
+	if result.Output != "" {
+		message.WriteString(fmt.Sprintf("```\n%s\n```\n", strings.TrimRight(result.Output, "\n")))
+	}
+	if result.Error != "" {
+		message.WriteString(fmt.Sprintf("- Script failed: `%s` ⚠️\n", result.Error))
+		return message.String(), &ScriptError{Message: strings.TrimSpace(result.Error + "\n" + result.Output)}
+	}
+	if result.Value != nil {
+		message.WriteString(fmt.Sprintf("- Result: `%s`\n", *result.Value))
+	}
+	if message.Len() == 0 {
+		message.WriteString("- Script finished without output.")
+	}
+	return message.String(), nil
+}
+
+func scriptMessages(message string) *[]action_kit_api.Message {
+	if message == "" {
+		return nil
+	}
+	return &[]action_kit_api.Message{
+		{
+			Message: message,
+			Type:    new("JENKINS"),
+		},
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptActionPrepare(t *testing.T) {
+	config.Config.ScriptActionEnabled = true
+	t.Cleanup(func() { config.Config.ScriptActionEnabled = false })
+	action := &scriptAction{}
+
+	var state ScriptActionState
+	_, err := action.Prepare(t.Context(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{"script": "println 'hello'", "rollbackScript": "println 'bye'"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "println 'hello'", state.Script)
+	assert.Equal(t, "println 'bye'", state.RollbackScript)
+
+	_, err = action.Prepare(t.Context(), &state, action_kit_api.PrepareActionRequestBody{Config: map[string]any{"script": " \n"}})
+	assert.ErrorContains(t, err, "The script must not be empty.")
+}
+
+func TestScriptActionRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		wantMessage string
+		wantErr     string
+	}{
+		{name: "output and value", response: `{"result":{"output":"hello\n","value":"42"}}`, wantMessage: "```\nhello\n```\n- Result: `42`\n"},
+		{name: "no output", response: `{"result":{"output":""}}`, wantMessage: "- Script finished without output."},
+		{name: "failed", response: `{"result":{"output":"step 1\n","error":"java.lang.IllegalStateException: broken"}}`,
+			wantMessage: "```\nstep 1\n```\n- Script failed: `java.lang.IllegalStateException: broken` ⚠️\n",
+			wantErr:     "script failed: java.lang.IllegalStateException: broken\nstep 1"},
+		{name: "template failed", response: `{"error":"groovy.lang.MissingPropertyException"}`, wantErr: "script failed: groovy.lang.MissingPropertyException"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/scriptText" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+			jenkins := gojenkins.CreateJenkins(server.Client(), server.URL)
+			jenkins.Requester = NewRetryingRequester(jenkins.Requester)
+			action := &scriptAction{jenkins: jenkins}
+			state := &ScriptActionState{Script: "println 'hello'", RollbackScript: "println 'bye'"}
+
+			result, err := action.Start(t.Context(), state)
+
+			assert.True(t, state.Started, "the rollback script runs also if the script failed")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantMessage == "" {
+				assert.Nil(t, result.Messages)
+			} else {
+				require.NotNil(t, result.Messages)
+				assert.Equal(t, tt.wantMessage, (*result.Messages)[0].Message)
+			}
+
+			stopResult, err := action.Stop(t.Context(), state)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.True(t, state.Started)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantMessage, (*stopResult.Messages)[0].Message)
+				assert.False(t, state.Started)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+)
+
+// scriptTemplate wraps a Groovy script, so its result or error is printed as JSON. The script itself must not print.
+const scriptTemplate = `import groovy.json.JsonOutput
+try {
+  def result = {
+%s
+  }()
+  println(JsonOutput.toJson([result: result]))
+} catch (Throwable e) {
+  println(JsonOutput.toJson([error: e.toString()]))
+}`
+
+type scriptResponse[T any] struct {
+	Result T      `json:"result"`
+	Error  string `json:"error"`
+}
+
+// ScriptError is returned if a Groovy script run in the Script Console failed.
+type ScriptError struct {
+	Message string
+}
+
+func (e *ScriptError) Error() string {
+	return fmt.Sprintf("script failed: %s", e.Message)
+}
+
+// runScript runs a Groovy script in the Script Console of Jenkins and returns the value returned by the script,
+// converted to JSON. The Script Console requires the Overall/Administer permission.
+func runScript[T any](ctx context.Context, jenkins *gojenkins.Jenkins, script string) (T, error) {
+	var response scriptResponse[T]
+	data := url.Values{"script": {fmt.Sprintf(scriptTemplate, script)}}
+	_, err := jenkins.Requester.Post(ctx, "/scriptText", bytes.NewBufferString(data.Encode()), &response, nil)
+	if err != nil {
+		return response.Result, err
+	}
+	if response.Error != "" {
+		return response.Result, &ScriptError{Message: response.Error}
+	}
+	return response.Result, nil
+}
+
+// isScriptConsoleDenied reports whether Jenkins refused to run a script, because the API user lacks Overall/Administer.
+func isScriptConsoleDenied(err error) bool {
+	var apiErr *JenkinsApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// groovyString quotes a value as single-quoted Groovy string, which is not interpolated.
+func groovyString(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`)
+	return "'" + replacer.Replace(value) + "'"
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/extension-jenkins/config"
+	extension_kit "github.com/steadybit/extension-kit"
+)
+
+const secretEnvironmentPrefix = "STEADYBIT_EXTENSION_SECRET_"
+
+var (
+	// Parameter types whose values are treated as secrets.
+	secretParameterTypes = []string{"PasswordParameterDefinition", "CredentialsParameterDefinition"}
+	// A parameter value like ${secret:DEPLOY_TOKEN} references a secret, which is resolved when the job is run.
+	secretReference = regexp.MustCompile(`^\$\{secret:([A-Za-z0-9_.-]+)}$`)
+)
+
+// secretParametersOfJob returns the names of the job's parameters holding secrets, like password parameters.
+func secretParametersOfJob(job *gojenkins.Job) []string {
+	var names []string
+	for _, property := range job.Raw.Property {
+		for _, definition := range property.ParameterDefinitions {
+			if slices.Contains(secretParameterTypes, definition.Type) {
+				names = append(names, definition.Name)
+			}
+		}
+	}
+	return names
+}
+
+// secretParameters returns the names of parameters to redact: declared secret parameters and parameters referencing
+// a secret.
+func secretParameters(parameters map[string]string, declared []string) []string {
+	names := slices.Clone(declared)
+	for name, value := range parameters {
+		if secretReference.MatchString(value) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// checkSecretParameters validates the secret parameters and returns the names of the parameters to redact. Declared
+// secret parameters only accept references to secrets, so their values never end up in the action state.
+func checkSecretParameters(parameters map[string]string, declared []string) ([]string, error) {
+	var literals []string
+	for _, name := range declared {
+		if value, ok := parameters[name]; ok && !secretReference.MatchString(value) {
+			literals = append(literals, name)
+		}
+	}
+	if len(literals) > 0 {
+		slices.Sort(literals)
+		return nil, extension_kit.ToError(fmt.Sprintf("The parameters %s hold secrets and only accept a reference to a secret provided to the extension, like ${secret:NAME}.", strings.Join(literals, ", ")), nil)
+	}
+	if _, err := resolveSecretReferences(parameters); err != nil {
+		return nil, err
+	}
+	return secretParameters(parameters, declared), nil
+}
+
+// resolveSecretReferences returns the parameters with secret references replaced by the secrets. Secrets are read from
+// files in the configured secrets directory or from environment variables prefixed with STEADYBIT_EXTENSION_SECRET_.
+// The resolved values must not be stored in the action state.
+func resolveSecretReferences(parameters map[string]string) (map[string]string, error) {
+	if parameters == nil {
+		return nil, nil
+	}
+	resolved := make(map[string]string, len(parameters))
+	for name, value := range parameters {
+		match := secretReference.FindStringSubmatch(value)
+		if match == nil {
+			resolved[name] = value
+			continue
+		}
+		secret, err := readSecret(match[1])
+		if err != nil {
+			return nil, extension_kit.ToError(fmt.Sprintf("Failed to resolve secret for parameter '%s'.", name), err)
+		}
+		resolved[name] = secret
+	}
+	return resolved, nil
+}
+
+func readSecret(name string) (string, error) {
+	if config.Config.SecretsDirectory != "" {
+		content, err := os.ReadFile(filepath.Join(config.Config.SecretsDirectory, name))
+		if err == nil {
+			return strings.TrimSpace(string(content)), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	variable := secretEnvironmentPrefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+	if value, ok := os.LookupEnv(variable); ok {
+		return value, nil
+	}
+	return "", fmt.Errorf("secret '%s' is neither a file in the secrets directory nor set as %s", name, variable)
+}
+
+// redactParameters replaces the values of secret parameters and parameters whose names suggest a secret.
+func redactParameters(parameters map[string]string, secrets []string) map[string]string {
+	redacted := make(map[string]string, len(parameters))
+	for name, value := range parameters {
+		if slices.Contains(secrets, name) || secretParameterName.MatchString(name) || secretReference.MatchString(value) {
+			value = redactedValue
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
+
+type secretParametersKey struct{}
+
+// withSecretParameters marks parameters to be redacted in audit records of requests made with the context.
+func withSecretParameters(ctx context.Context, names []string) context.Context {
+	return context.WithValue(ctx, secretParametersKey{}, names)
+}
+
+func secretParametersFromContext(ctx context.Context) []string {
+	names, _ := ctx.Value(secretParametersKey{}).([]string)
+	return names
+}
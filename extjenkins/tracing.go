@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/steadybit/extension-jenkins/exttracing"
+)
+
+// startJobSpan starts a span for a lifecycle call of an action, carrying the experiment execution and job.
+func startJobSpan(ctx context.Context, name string, correlation BuildCorrelation, jobName string) (context.Context, *exttracing.Span) {
+	return startActionSpan(ctx, name, correlation, map[string]any{"jenkins.job.name": jobName})
+}
+
+// startActionSpan starts a span for a lifecycle call of an action, carrying the experiment execution and the given
+// attributes of the target.
+func startActionSpan(ctx context.Context, name string, correlation BuildCorrelation, attributes map[string]any) (context.Context, *exttracing.Span) {
+	if attributes == nil {
+		attributes = make(map[string]any, 2)
+	}
+	attributes["steadybit.experiment.key"] = correlation.ExperimentKey
+	attributes["steadybit.execution.id"] = correlation.ExecutionId
+	return exttracing.Start(ctx, name, exttracing.SpanKindInternal, attributes)
+}
+
+// tracingTransport creates a client span for every request sent to Jenkins and passes the trace context to Jenkins
+// in the traceparent header.
+type tracingTransport struct {
+	delegate http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if !exttracing.Enabled() {
+		return t.delegate.RoundTrip(request)
+	}
+	endpoint := normalizeEndpoint(request.URL.Path)
+	_, span := exttracing.Start(request.Context(), fmt.Sprintf("%s %s", request.Method, endpoint), exttracing.SpanKindClient, map[string]any{
+		"http.request.method": request.Method,
+		"url.path":            request.URL.Path,
+		"server.address":      request.URL.Host,
+	})
+	defer span.End()
+
+	request = request.Clone(request.Context())
+	span.Inject(request.Header)
+	response, err := t.delegate.RoundTrip(request)
+	if err != nil {
+		span.RecordError(err)
+		return response, err
+	}
+	span.SetAttribute("http.response.status_code", response.StatusCode)
+	if response.StatusCode >= http.StatusBadRequest {
+		span.RecordError(fmt.Errorf("status %s", response.Status))
+	}
+	return response, err
+}
+
+func (t *tracingTransport) CloseIdleConnections() {
+	if closer, ok := t.delegate.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jenkins/config"
+	"github.com/steadybit/extension-jenkins/exttracing"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+type viewDiscovery struct {
+	jenkins *gojenkins.Jenkins
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber    = (*viewDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber = (*viewDiscovery)(nil)
+)
+
+func NewViewDiscovery(jenkins *gojenkins.Jenkins) discovery_kit_sdk.TargetDiscovery {
+	discovery := &viewDiscovery{jenkins: jenkins}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 5*time.Minute),
+	)
+}
+
+func (d *viewDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: TargetTypeView,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new("1m"),
+		},
+	}
+}
+
+func (d *viewDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      TargetTypeView,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(TargetIconView),
+
+		Label: discovery_kit_api.PluralLabel{One: "Jenkins View", Other: "Jenkins Views"},
+
+		Category: new("Jenkins"),
+
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "jenkins.view.name.full"},
+				{Attribute: "jenkins.view.job.count"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "jenkins.view.name.full",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *viewDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "jenkins.view.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View name",
+				Other: "View names",
+			},
+		},
+		{
+			Attribute: "jenkins.view.name.full",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View full name",
+				Other: "View full names",
+			},
+		},
+		{
+			Attribute: "jenkins.view.parent",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View parent",
+				Other: "View parents",
+			},
+		},
+		{
+			Attribute: "jenkins.view.url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View url",
+				Other: "View urls",
+			},
+		},
+		{
+			Attribute: "jenkins.view.job",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View job",
+				Other: "View jobs",
+			},
+		},
+		{
+			Attribute: "jenkins.view.job.count",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "View job count",
+				Other: "View job counts",
+			},
+		},
+	}
+}
+
+// viewAttributes lists all attributes of view targets, which may be excluded through DiscoveryAttributesExcludesView.
+var viewAttributes = []string{
+	"jenkins.view.name",
+	"jenkins.view.name.full",
+	"jenkins.view.parent",
+	"jenkins.view.url",
+	"jenkins.view.class",
+	"jenkins.view.job",
+	"jenkins.view.job.count",
+}
+
+func (d *viewDiscovery) DiscoverTargets(ctx context.Context) (_ []discovery_kit_api.Target, err error) {
+	ctx, span := exttracing.Start(ctx, "jenkins.discovery.views", exttracing.SpanKindInternal, nil)
+	defer span.EndWithError(&err)
+
+	start := time.Now()
+	views, err := getViews(ctx, d.jenkins)
+	if err != nil {
+		observeDiscovery(TargetTypeView, start, 0, err)
+		return nil, toJenkinsError("Failed to fetch views.", err)
+	}
+
+	targets := make([]discovery_kit_api.Target, len(views))
+	for i, v := range views {
+		targets[i] = discovery_kit_api.Target{
+			Id:         v.FullName,
+			TargetType: TargetTypeView,
+			Label:      v.FullName,
+			Attributes: map[string][]string{
+				"jenkins.view.name":      {v.Name},
+				"jenkins.view.name.full": {v.FullName},
+				"jenkins.view.url":       {v.Url},
+				"jenkins.view.class":     {v.Class},
+				"jenkins.view.job.count": {strconv.Itoa(len(v.Jobs))},
+			},
+		}
+		if len(v.Paths) > 1 {
+			targets[i].Attributes["jenkins.view.parent"] = []string{v.Paths[len(v.Paths)-2]}
+		}
+		if len(v.Jobs) > 0 {
+			jobs := make([]string, len(v.Jobs))
+			for j, job := range v.Jobs {
+				jobs[j] = job.Name
+			}
+			targets[i].Attributes["jenkins.view.job"] = jobs
+		}
+	}
+	observeDiscovery(TargetTypeView, start, len(targets), nil)
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesView), nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjenkins
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+)
+
+const (
+	allViewClass = "hudson.model.AllView"
+	// viewNestingDepth limits how deep nested views are fetched.
+	viewNestingDepth = 5
+)
+
+// view is a view of Jenkins as listed by /api/json. Only nested views contain further views.
+type view struct {
+	Class string               `json:"_class"`
+	Name  string               `json:"name"`
+	Url   string               `json:"url"`
+	Jobs  []gojenkins.InnerJob `json:"jobs"`
+	Views []view               `json:"views"`
+}
+
+// discoveredView is a view with the names of all its parents.
+type discoveredView struct {
+	view
+	// FullName contains the names of the parent views and the view, separated by '/'.
+	FullName string
+	// Paths lists the full names of the view and all its parents.
+	Paths []string
+}
+
+// getViews lists all views of Jenkins including nested views, except for the view showing all jobs.
+func getViews(ctx context.Context, jenkins *gojenkins.Jenkins) ([]discoveredView, error) {
+	var response struct {
+		Views []view `json:"views"`
+	}
+	query := map[string]string{"tree": viewTree(viewNestingDepth)}
+	if _, err := jenkins.Requester.GetJSON(ctx, "/", &response, query); err != nil {
+		return nil, err
+	}
+	return flattenViews(response.Views, nil), nil
+}
+
+func viewTree(depth int) string {
+	fields := "_class,name,url,jobs[name,url]"
+	if depth > 1 {
+		fields += "," + viewTree(depth-1)
+	}
+	return "views[" + fields + "]"
+}
+
+func flattenViews(views []view, parents []string) []discoveredView {
+	var result []discoveredView
+	for _, v := range views {
+		if v.Class == allViewClass {
+			continue
+		}
+		names := append(append([]string{}, parents...), v.Name)
+		paths := make([]string, len(names))
+		for i := range names {
+			paths[i] = strings.Join(names[:i+1], "/")
+		}
+		result = append(result, discoveredView{view: v, FullName: paths[len(paths)-1], Paths: paths})
+		result = append(result, flattenViews(v.Views, names)...)
+	}
+	return result
+}
+
+// viewsOfJob returns the full names of all views containing the job with the given url, directly or through a
+// folder, and their parents.
+func viewsOfJob(views []discoveredView, jobUrl string) []string {
+	var result []string
+	for _, v := range views {
+		for _, item := range v.Jobs {
+			if item.Url != "" && strings.HasPrefix(jobUrl, item.Url) {
+				for _, path := range v.Paths {
+					if !slices.Contains(result, path) {
+						result = append(result, path)
+					}
+				}
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+// Package exttracing records spans with the OpenTelemetry SDK and exports them via OTLP/HTTP. Trace context is
+// propagated in the W3C Trace Context format. As long as tracing is not enabled, all functions are no-ops.
+package exttracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/steadybit/extension-jenkins"
+	exportInterval      = 5 * time.Second
+	exportTimeout       = 10 * time.Second
+	maxQueuedSpans      = 2048
+)
+
+type SpanKind int
+
+const (
+	SpanKindInternal = SpanKind(trace.SpanKindInternal)
+	SpanKindClient   = SpanKind(trace.SpanKindClient)
+)
+
+// Span is an operation in a trace. A nil span is valid and records nothing.
+type Span struct {
+	span trace.Span
+}
+
+var (
+	provider   *sdktrace.TracerProvider
+	tracer     trace.Tracer
+	propagator = propagation.TraceContext{}
+)
+
+// Enabled reports whether spans are recorded.
+func Enabled() bool {
+	return provider != nil
+}
+
+// Start starts a span as child of the span in the context. The returned context carries the new span.
+func Start(ctx context.Context, name string, kind SpanKind, attributes map[string]any) (context.Context, *Span) {
+	if !Enabled() {
+		return ctx, nil
+	}
+	keyValues := make([]attribute.KeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		keyValues = append(keyValues, toAttribute(key, value))
+	}
+	ctx, span := tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKind(kind)), trace.WithAttributes(keyValues...))
+	return ctx, &Span{span: span}
+}
+
+// SpanFromContext returns the current span of the context or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span := trace.SpanFromContext(ctx)
+	if !Enabled() || !span.SpanContext().IsValid() {
+		return nil
+	}
+	return &Span{span: span}
+}
+
+func (s *Span) SetAttribute(key string, value any) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(toAttribute(key, value))
+}
+
+// RecordError marks the span as failed.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End finishes the span and hands it to the exporter. Subsequent calls have no effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.span.End()
+}
+
+// Inject adds the traceparent header for the span to the request headers.
+func (s *Span) Inject(header http.Header) {
+	if s == nil {
+		return
+	}
+	propagator.Inject(trace.ContextWithSpan(context.Background(), s.span), propagation.HeaderCarrier(header))
+}
+
+// EndWithError records the error, if any, and ends the span. Meant to be deferred with a pointer to the named error
+// result of the traced function.
+func (s *Span) EndWithError(err *error) {
+	if err != nil {
+		s.RecordError(*err)
+	}
+	s.End()
+}
+
+// Init enables tracing, exporting spans in batches to the OTLP/HTTP endpoint, like 'http://otel-collector:4318'.
+func Init(endpoint string, serviceName string, serviceVersion string) error {
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(strings.TrimSuffix(endpoint, "/")+"/v1/traces"),
+		otlptracehttp.WithTimeout(exportTimeout),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+	serviceResource, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", serviceVersion),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(exportInterval),
+			sdktrace.WithMaxQueueSize(maxQueuedSpans),
+		),
+		sdktrace.WithResource(serviceResource),
+	)
+	tracer = provider.Tracer(instrumentationName)
+	return nil
+}
+
+// Shutdown exports all pending spans and stops the exporter.
+func Shutdown() error {
+	if provider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	return provider.Shutdown(ctx)
+}
+
+func toAttribute(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
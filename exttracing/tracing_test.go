@@ -0,0 +1,140 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package exttracing
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// startCollector records the spans exported to it.
+func startCollector(t *testing.T) (*httptest.Server, func() []*tracev1.Span) {
+	var (
+		mu    sync.Mutex
+		spans []*tracev1.Span
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var request collectortrace.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &request))
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, resourceSpans := range request.ResourceSpans {
+			assert.Contains(t, resourceSpans.Resource.Attributes, stringAttribute("service.name", "extension-jenkins-test"))
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				spans = append(spans, scopeSpans.Spans...)
+			}
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []*tracev1.Span {
+		mu.Lock()
+		defer mu.Unlock()
+		return spans
+	}
+}
+
+func stringAttribute(key, value string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: value}}}
+}
+
+func attributesOf(span *tracev1.Span) map[string]any {
+	attributes := map[string]any{}
+	for _, keyValue := range span.Attributes {
+		switch v := keyValue.Value.Value.(type) {
+		case *commonv1.AnyValue_StringValue:
+			attributes[keyValue.Key] = v.StringValue
+		case *commonv1.AnyValue_IntValue:
+			attributes[keyValue.Key] = v.IntValue
+		case *commonv1.AnyValue_BoolValue:
+			attributes[keyValue.Key] = v.BoolValue
+		case *commonv1.AnyValue_DoubleValue:
+			attributes[keyValue.Key] = v.DoubleValue
+		}
+	}
+	return attributes
+}
+
+func TestDisabledTracingIsNoop(t *testing.T) {
+	require.False(t, Enabled())
+
+	ctx, span := Start(t.Context(), "noop", SpanKindInternal, map[string]any{"key": "value"})
+	header := http.Header{}
+	span.SetAttribute("other", 1)
+	span.RecordError(errors.New("failed"))
+	span.Inject(header)
+	span.End()
+
+	assert.Nil(t, span)
+	assert.Nil(t, SpanFromContext(ctx))
+	assert.Empty(t, header)
+	assert.NoError(t, Shutdown())
+}
+
+func TestExport(t *testing.T) {
+	collector, exported := startCollector(t)
+	require.NoError(t, Init(collector.URL+"/", "extension-jenkins-test", "v1.0.0"))
+	t.Cleanup(func() { provider, tracer = nil, nil })
+
+	ctx, parent := Start(t.Context(), "jenkins.job.run.start", SpanKindInternal, map[string]any{
+		"jenkins.job.name":       "deploy",
+		"steadybit.execution.id": 4711,
+		"dry":                    true,
+		"ratio":                  0.5,
+		"other":                  []string{"a"},
+	})
+	assert.Same(t, parent.span, SpanFromContext(ctx).span)
+	_, child := Start(ctx, "POST /job/:name/build", SpanKindClient, nil)
+	header := http.Header{}
+	child.Inject(header)
+	child.SetAttribute("http.response.status_code", int64(201))
+	var err = errors.New("queue is full")
+	child.EndWithError(&err)
+	child.End()
+	parent.End()
+	require.NoError(t, Shutdown())
+
+	spans := exported()
+	require.Len(t, spans, 2, "spans ended twice are exported once")
+	childSpan, parentSpan := spans[0], spans[1]
+
+	assert.Equal(t, "jenkins.job.run.start", parentSpan.Name)
+	assert.Equal(t, tracev1.Span_SPAN_KIND_INTERNAL, parentSpan.Kind)
+	assert.Empty(t, parentSpan.ParentSpanId)
+	assert.Equal(t, map[string]any{
+		"jenkins.job.name":       "deploy",
+		"steadybit.execution.id": int64(4711),
+		"dry":                    true,
+		"ratio":                  0.5,
+		"other":                  "[a]",
+	}, attributesOf(parentSpan))
+
+	assert.Equal(t, "POST /job/:name/build", childSpan.Name)
+	assert.Equal(t, tracev1.Span_SPAN_KIND_CLIENT, childSpan.Kind)
+	assert.Equal(t, parentSpan.TraceId, childSpan.TraceId)
+	assert.Equal(t, parentSpan.SpanId, childSpan.ParentSpanId)
+	assert.Equal(t, int64(201), attributesOf(childSpan)["http.response.status_code"])
+	assert.Equal(t, tracev1.Status_STATUS_CODE_ERROR, childSpan.Status.Code)
+	assert.Equal(t, "queue is full", childSpan.Status.Message)
+
+	assert.Regexp(t, regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-01$`), header.Get("traceparent"))
+}
@@ -6,11 +6,12 @@ package main
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
+	"os"
 
 	_ "github.com/KimMachineGun/automemlimit" // By default, it sets `GOMEMLIMIT` to 90% of cgroup's memory limit.
 	"github.com/bndr/gojenkins"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/steadybit/action-kit/go/action_kit_api/v2"
@@ -19,6 +20,7 @@ import (
 	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
 	"github.com/steadybit/extension-jenkins/config"
 	"github.com/steadybit/extension-jenkins/extjenkins"
+	"github.com/steadybit/extension-jenkins/exttracing"
 	"github.com/steadybit/extension-kit/extbuild"
 	"github.com/steadybit/extension-kit/exthealth"
 	"github.com/steadybit/extension-kit/exthttp"
@@ -36,33 +38,80 @@ func main() {
 
 	config.ParseConfiguration()
 	config.ValidateConfiguration()
+	extjenkins.ValidateAttributeExcludes()
+
+	if config.Config.TracingOtlpEndpoint != "" {
+		if err := exttracing.Init(config.Config.TracingOtlpEndpoint, config.Config.TracingServiceName, extbuild.GetSemverVersionStringOrUnknown()); err != nil {
+			log.Fatal().Err(err).Msgf("Failed to initialize tracing")
+		}
+		extsignals.AddSignalHandler(extsignals.SignalHandler{
+			Handler: func(_ os.Signal) {
+				if err := exttracing.Shutdown(); err != nil {
+					log.Warn().Err(err).Msg("Failed to export pending spans.")
+				}
+			},
+			Order:   extsignals.OrderStopCustom,
+			Name:    "tracing",
+		})
+		log.Info().Str("endpoint", config.Config.TracingOtlpEndpoint).Msg("Exporting traces via OTLP.")
+	}
 
 	exthealth.SetReady(false)
 	exthealth.StartProbes(8083)
 
 	ctx := context.Background()
 
-	// Create HTTP client with optional TLS configuration for self-signed certificates
-	var httpClient *http.Client
-	if config.Config.InsecureSkipVerify {
-		log.Info().Msg("TLS verification disabled for Jenkins connection. Self-signed certificates will be accepted.")
-		insecureTransport := &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //NOSONAR explicit choice
+	if config.Config.AuditLog != "" {
+		if err := extjenkins.InitAuditLog(config.Config.AuditLog); err != nil {
+			log.Fatal().Err(err).Msgf("Failed to initialize audit log")
 		}
-		httpClient = &http.Client{Transport: insecureTransport}
+		log.Info().Str("target", config.Config.AuditLog).Msg("Writing audit log of mutating Jenkins requests.")
 	}
 
-	jenkins := gojenkins.CreateJenkins(httpClient, config.Config.BaseUrl, config.Config.ApiUser, config.Config.ApiToken)
-	_, err := jenkins.Init(ctx)
+	httpClient, err := extjenkins.NewHttpClient()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create HTTP client for Jenkins")
+	}
+
+	jenkins := gojenkins.CreateJenkins(httpClient, config.Config.BaseUrl)
+	jenkins.Requester = extjenkins.NewAuditingRequester(extjenkins.NewRetryingRequester(jenkins.Requester))
+	if err = extjenkins.CheckPermissions(ctx, jenkins); err != nil {
+		log.Fatal().Err(err).Msgf("Permission self-test against Jenkins at %s failed", config.Config.BaseUrl)
+	}
+	_, err = jenkins.Init(ctx)
 
 	if err != nil {
 		log.Fatal().Err(err).Msgf("Failed to connect to Jenkins at %s", config.Config.BaseUrl)
 	}
 
+	discovery_kit_sdk.Register(extjenkins.NewControllerDiscovery(jenkins))
 	discovery_kit_sdk.Register(extjenkins.NewJobDiscovery(jenkins))
+	discovery_kit_sdk.Register(extjenkins.NewViewDiscovery(jenkins))
+	discovery_kit_sdk.Register(extjenkins.NewBuildDiscovery(jenkins))
 	action_kit_sdk.RegisterAction(extjenkins.NewJobRunAction(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewJobRunManyAction(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewJobFloodQueueAction(jenkins))
+	discovery_kit_sdk.Register(extjenkins.NewLockableResourceDiscovery(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewLockableResourceReserveAction(jenkins))
+	discovery_kit_sdk.Register(extjenkins.NewNodeDiscovery(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewNodeDisconnectAction(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewNodeReduceExecutorsAction(jenkins))
+	action_kit_sdk.RegisterAction(extjenkins.NewNodeRemoveLabelsAction(jenkins))
+	if config.Config.CloudsEnabled {
+		discovery_kit_sdk.Register(extjenkins.NewCloudDiscovery(jenkins))
+		discovery_kit_sdk.Register(extjenkins.NewPodTemplateDiscovery(jenkins))
+		action_kit_sdk.RegisterAction(extjenkins.NewCloudCapAction(jenkins))
+		action_kit_sdk.RegisterAction(extjenkins.NewPodTemplateCapAction(jenkins))
+	}
+	if config.Config.ScriptActionEnabled {
+		action_kit_sdk.RegisterAction(extjenkins.NewScriptAction(jenkins))
+	}
 
 	exthttp.RegisterRevisionedHandler("/", getExtensionList)
+	metricsHandler := promhttp.Handler()
+	exthttp.RegisterHttpHandlerWithLogLevel("/metrics", func(w http.ResponseWriter, r *http.Request, _ []byte) {
+		metricsHandler.ServeHTTP(w, r)
+	}, zerolog.DebugLevel)
 
 	extsignals.ActivateSignalHandlers()
 